@@ -13,6 +13,9 @@ package main
 // Builds the Ruby Vagrant Go GRPC for legacy Vagrant interactions
 //go:generate sh -c "protoc -I./thirdparty/proto/api-common-protos -I./internal/server -I`go list -m -f \"{{.Dir}}\" github.com/mitchellh/protostructure` -I`go list -m -f \"{{.Dir}}\" github.com/hashicorp/vagrant-plugin-sdk`/proto/vagrant_plugin_sdk --go-grpc_out=./internal/server/proto/ruby_vagrant --go-grpc_opt=module=github.com/hashicorp/vagrant/internal/server/proto/ruby_vagrant --go_out=./internal/server/proto/ruby_vagrant --go_opt=module=github.com/hashicorp/vagrant/internal/server/proto/ruby_vagrant internal/server/proto/ruby_vagrant/*.proto"
 
+// Builds the Go GRPC for typed arguments provided to command plugins
+//go:generate sh -c "protoc -I`go list -m -f \"{{.Dir}}\" github.com/mitchellh/protostructure` -I`go list -m -f \"{{.Dir}}\" github.com/hashicorp/vagrant-plugin-sdk`/proto/vagrant_plugin_sdk -I./thirdparty/proto/api-common-protos -I./internal/plugin --go-grpc_out=require_unimplemented_servers=false:./internal/plugin/proto/vagrant_command --go-grpc_opt=module=github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command --go_out=./internal/plugin/proto/vagrant_command --go_opt=module=github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command internal/plugin/proto/vagrant_command/*.proto"
+
 // Builds the Ruby GRPC for the Vagrant server and Ruby Vagrant interactions
 //go:generate sh -c "grpc_tools_ruby_protoc -I`go list -m -f \"{{.Dir}}\" github.com/mitchellh/protostructure` -I`go list -m -f \"{{.Dir}}\" github.com/hashicorp/vagrant-plugin-sdk`/proto/vagrant_plugin_sdk -I./thirdparty/proto/api-common-protos -I./internal/server --grpc_out=./lib/vagrant/protobufs/ --ruby_out=./lib/vagrant/protobufs/ internal/server/proto/vagrant_server/*.proto internal/server/proto/ruby_vagrant/*.proto"
 
@@ -372,7 +372,7 @@ func (c *baseCommand) Init(opts ...Option) (err error) {
 
 	// Validate remote vs. local operations.
 	if c.flagRemote && c.target == nil {
-		if c.cfg == nil || c.cfg.Runner == nil || !c.cfg.Runner.Enabled {
+		if c.cfg == nil || !c.cfg.Runner.IsEnabled() {
 			err := errors.New(
 				"The `-remote` flag was specified but remote operations are not supported\n" +
 					"for this project.\n\n" +
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
 	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
 	"github.com/hashicorp/vagrant/internal/client"
+	"github.com/hashicorp/vagrant/internal/core"
 	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
 )
 
@@ -92,6 +93,16 @@ func (c *DynamicCommand) Run(args []string) int {
 					// All user-facing errors from Ruby use a 1 exit code. See
 					// Vagrant::Errors::VagrantError.
 					r.ExitCode = 1
+				case *errdetails.ErrorInfo:
+					// Structured command failures have already been
+					// output as an error block by the runner.
+					if m.Domain != core.CommandFailureDomain {
+						continue
+					}
+					userError = true
+					if r.ExitCode == 0 {
+						r.ExitCode = 1
+					}
 				}
 			}
 			// If there wasn't a user-facing error, just assign the returned
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// Component is the configuration for a plugin component. The
+// labels are the component type, such as "provider", and the
+// name of the plugin providing the component:
+//
+//	component "provider" "virtualbox" {
+//	  gui = true
+//	}
+//
+// The body is decoded using the schema of the component.
+type Component struct {
+	Type string   `hcl:"type,label"`
+	Name string   `hcl:"name,label"`
+	Body hcl.Body `hcl:",remain"`
+
+	ctx *hcl.EvalContext
+}
+
+// Component returns the configuration for the component with
+// the given type and plugin name. The type is matched without
+// regard to case. If no configuration is defined nil is returned.
+func (c *Config) Component(typ, name string) *Component {
+	for _, comp := range c.Components {
+		if strings.EqualFold(comp.Type, typ) && comp.Name == name {
+			return comp
+		}
+	}
+
+	return nil
+}
+
+// Decode the component configuration into the schema. The schema
+// must be a pointer to a struct using hcl tags.
+func (c *Component) Decode(schema interface{}) error {
+	if diags := gohcl.DecodeBody(c.Body, c.ctx, schema); diags.HasErrors() {
+		return diags
+	}
+
+	return nil
+}
+
+// EvalContext returns the context used to evaluate the
+// component configuration
+func (c *Component) EvalContext() *hcl.EvalContext {
+	return c.ctx
+}
+
+// Values returns the attributes of the component configuration
+// without using a schema. Nested blocks are not supported.
+func (c *Component) Values() (map[string]interface{}, error) {
+	attrs, diags := c.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	result := make(map[string]interface{}, len(attrs))
+	for name, attr := range attrs {
+		v, diags := attr.Expr.Value(c.ctx)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		raw, err := ctyjson.Marshal(v, v.Type())
+		if err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, err
+		}
+		result[name] = value
+	}
+
+	return result, nil
+}
+
+// Replace the component configuration with the same type and
+// name, or append it if no configuration exists
+func mergeComponent(comps []*Component, comp *Component) []*Component {
+	for i, existing := range comps {
+		if strings.EqualFold(existing.Type, comp.Type) && existing.Name == comp.Name {
+			comps[i] = comp
+			return comps
+		}
+	}
+
+	return append(comps, comp)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponent(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "vagrant-config.hcl")
+	require.NoError(t, os.WriteFile(path, []byte(`
+component "provider" "virtualbox" {
+  gui    = true
+  memory = 1024
+  name   = "vm-${upper("a")}"
+}
+`), 0644))
+
+	cfg, err := Load(path, dir)
+	require.NoError(t, err)
+	require.Nil(t, cfg.Component("provider", "docker"))
+
+	comp := cfg.Component("Provider", "virtualbox")
+	require.NotNil(t, comp)
+
+	values, err := comp.Values()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"gui": true, "memory": float64(1024), "name": "vm-A",
+	}, values)
+
+	var schema struct {
+		GUI    bool `hcl:"gui,optional"`
+		Memory int  `hcl:"memory"`
+	}
+	require.Error(t, comp.Decode(&schema))
+
+	var full struct {
+		GUI    bool   `hcl:"gui,optional"`
+		Memory int    `hcl:"memory"`
+		Name   string `hcl:"name,optional"`
+	}
+	require.NoError(t, comp.Decode(&full))
+	require.True(t, full.GUI)
+	require.Equal(t, 1024, full.Memory)
+
+	// Later configurations replace the component configuration
+	result := Merge(cfg, &Config{Components: []*Component{{Type: "provider", Name: "virtualbox"}}})
+	require.Len(t, result.Components, 1)
+	require.Nil(t, result.Components[0].Body)
+}
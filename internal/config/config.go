@@ -18,8 +18,15 @@ import (
 // Vagrant server/runners.
 // This does not include Vagrantfile type config
 type Config struct {
-	Runner *Runner           `hcl:"runner,block" default:"{}"`
-	Labels map[string]string `hcl:"labels,optional"`
+	Runner          *Runner           `hcl:"runner,block" default:"{}"`
+	Labels          map[string]string `hcl:"labels,optional"`
+	Plugins         *Plugins          `hcl:"plugins,block"`
+	Retries         []*Retry          `hcl:"retry,block"`
+	Aliases         *Aliases          `hcl:"aliases,block"`
+	Capabilities    *Capabilities     `hcl:"capabilities,block"`
+	Components      []*Component      `hcl:"component,block"`
+	DefaultProvider string            `hcl:"default_provider,optional"`
+	Targets         []*Target         `hcl:"target,block"`
 
 	pathData map[string]string
 	ctx      *hcl.EvalContext
@@ -28,13 +35,62 @@ type Config struct {
 // Runner is the configuration for supporting runners in this project.
 type Runner struct {
 	// Enabled is whether or not runners are enabled. If this is false
-	// then the "-remote" flag will not work.
-	Enabled bool
+	// then the "-remote" flag will not work. Nil when not set so
+	// merging can tell an unset value from a disabled one.
+	Enabled *bool
 
 	// DataSource is the default data source when a remote job is queued.
 	DataSource *DataSource
 }
 
+// IsEnabled returns if runners are enabled
+func (r *Runner) IsEnabled() bool {
+	return r != nil && r.Enabled != nil && *r.Enabled
+}
+
+// Plugins restricts which plugins are allowed to run. Entries
+// are glob patterns in the format `type:name`.
+type Plugins struct {
+	Allow []string `hcl:"allow,optional"`
+	Deny  []string `hcl:"deny,optional"`
+}
+
+// Aliases maps alternative names to commands. An alias with the
+// same name as an existing command is ignored unless AllowShadow
+// is enabled.
+type Aliases struct {
+	Commands    map[string]string `hcl:"commands,optional"`
+	AllowShadow bool              `hcl:"allow_shadow,optional"`
+}
+
+// Capabilities configures the behavior when an operation requires
+// a capability which the host or provider does not support. The
+// OnMissing value is either "error" to abort the operation or
+// "skip" to skip it.
+type Capabilities struct {
+	OnMissing string `hcl:"on_missing,optional"`
+}
+
+// Retry configures retries for failed operations. The operation
+// label is the operation type the retry applies to, or `*` to
+// apply to all operations.
+type Retry struct {
+	Operation  string  `hcl:"operation,label"`
+	Attempts   int     `hcl:"attempts,optional"`
+	Backoff    string  `hcl:"backoff,optional"`
+	MaxBackoff string  `hcl:"max_backoff,optional"`
+	Jitter     float64 `hcl:"jitter,optional"`
+}
+
+// Target configures a target within the basis. The name label
+// is the name of the target. DependsOn names the targets which
+// must be run before the target when running a command on
+// multiple targets.
+type Target struct {
+	Name      string   `hcl:"name,label"`
+	DependsOn []string `hcl:"depends_on,optional"`
+}
+
 // DataSource configures the data source for the runner.
 type DataSource struct {
 	Type string
@@ -82,5 +138,11 @@ func Load(path string, pwd string) (*Config, error) {
 		return nil, err
 	}
 
+	// Component configuration is decoded later using the
+	// schema of the component so retain the context
+	for _, comp := range cfg.Components {
+		comp.ctx = ctx
+	}
+
 	return &cfg, nil
 }
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EnvRunnerEnabled overrides the runner enabled setting
+	EnvRunnerEnabled = "VAGRANT_RUNNER_ENABLED"
+
+	// EnvLabels provides additional labels as a comma
+	// separated list of key=value pairs
+	EnvLabels = "VAGRANT_LABELS"
+)
+
+// Merge combines the given configurations into a single configuration.
+// Configurations are applied in order so values from later configurations
+// take precedence over earlier ones. Labels are merged by key. Runner
+// enabled is taken from the last configuration setting it, and the
+// data source from the last configuration defining one. Plugin restrictions are replaced by any later
+// configuration defining them. Retries are merged by operation. Aliases
+// are merged by name, with the shadow setting taken from the last
+// configuration defining aliases. Capability settings are replaced
+// by any later configuration defining them. Component configurations
+// are merged by type and name. The default provider is taken from the
+// last configuration setting it. Target configurations are replaced
+// by name. Nil configurations are ignored.
+func Merge(cfgs ...*Config) *Config {
+	result := &Config{
+		Runner: &Runner{},
+		Labels: map[string]string{},
+	}
+
+	for _, c := range cfgs {
+		if c == nil {
+			continue
+		}
+
+		if c.Runner != nil {
+			if c.Runner.Enabled != nil {
+				enabled := *c.Runner.Enabled
+				result.Runner.Enabled = &enabled
+			}
+			if c.Runner.DataSource != nil {
+				result.Runner.DataSource = c.Runner.DataSource
+			}
+		}
+
+		for k, v := range c.Labels {
+			result.Labels[k] = v
+		}
+
+		if c.Plugins != nil {
+			result.Plugins = c.Plugins
+		}
+
+		for _, r := range c.Retries {
+			result.Retries = mergeRetry(result.Retries, r)
+		}
+
+		if c.Aliases != nil {
+			if result.Aliases == nil {
+				result.Aliases = &Aliases{Commands: map[string]string{}}
+			}
+			for k, v := range c.Aliases.Commands {
+				result.Aliases.Commands[k] = v
+			}
+			result.Aliases.AllowShadow = c.Aliases.AllowShadow
+		}
+
+		if c.Capabilities != nil {
+			result.Capabilities = c.Capabilities
+		}
+
+		for _, comp := range c.Components {
+			result.Components = mergeComponent(result.Components, comp)
+		}
+
+		if c.DefaultProvider != "" {
+			result.DefaultProvider = c.DefaultProvider
+		}
+
+		for _, t := range c.Targets {
+			result.Targets = mergeTarget(result.Targets, t)
+		}
+
+		if c.pathData != nil {
+			result.pathData = c.pathData
+		}
+		if c.ctx != nil {
+			result.ctx = c.ctx
+		}
+	}
+
+	return result
+}
+
+// Add the retry to the list, replacing any existing
+// retry for the same operation
+func mergeRetry(retries []*Retry, r *Retry) []*Retry {
+	for i, existing := range retries {
+		if existing.Operation == r.Operation {
+			retries[i] = r
+			return retries
+		}
+	}
+
+	return append(retries, r)
+}
+
+// Add the target to the list, replacing any existing
+// target with the same name
+func mergeTarget(targets []*Target, t *Target) []*Target {
+	for i, existing := range targets {
+		if existing.Name == t.Name {
+			targets[i] = t
+			return targets
+		}
+	}
+
+	return append(targets, t)
+}
+
+// ApplyEnv applies any overrides defined within the environment
+// to the configuration. Environment values take precedence over
+// all configuration file values.
+func ApplyEnv(c *Config) error {
+	if c.Runner == nil {
+		c.Runner = &Runner{}
+	}
+	if c.Labels == nil {
+		c.Labels = map[string]string{}
+	}
+
+	if v := os.Getenv(EnvRunnerEnabled); v != "" {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%s value %q is not a valid boolean", EnvRunnerEnabled, v)
+		}
+		c.Runner.Enabled = &enabled
+	}
+
+	if v := os.Getenv(EnvLabels); v != "" {
+		for _, pair := range strings.Split(v, ",") {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return fmt.Errorf("%s value %q is not a valid key=value pair", EnvLabels, pair)
+			}
+			c.Labels[parts[0]] = parts[1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	enabled := true
+	global := &Config{
+		Runner: &Runner{Enabled: &enabled},
+		Labels: map[string]string{"env": "global", "team": "ops"},
+	}
+	local := &Config{
+		Runner: &Runner{},
+		Labels: map[string]string{"env": "local"},
+	}
+
+	result := Merge(global, nil, local)
+	require.True(t, result.Runner.IsEnabled())
+	require.Equal(t, map[string]string{"env": "local", "team": "ops"}, result.Labels)
+
+	// Sources should not be modified
+	require.Equal(t, "global", global.Labels["env"])
+}
+
+func TestMergeRunnerEnabled(t *testing.T) {
+	enabled, disabled := true, false
+	global := &Config{Runner: &Runner{Enabled: &enabled}}
+	local := &Config{Runner: &Runner{Enabled: &disabled}}
+
+	// Last configuration setting the value wins
+	require.False(t, Merge(global, local).Runner.IsEnabled())
+	require.True(t, Merge(local, global).Runner.IsEnabled())
+
+	// Unset values do not override
+	require.True(t, Merge(global, &Config{Runner: &Runner{}}).Runner.IsEnabled())
+	require.False(t, Merge().Runner.IsEnabled())
+
+	// Sources should not be modified
+	result := Merge(global)
+	*result.Runner.Enabled = false
+	require.True(t, *global.Runner.Enabled)
+}
+
+func TestApplyEnv(t *testing.T) {
+	t.Setenv(EnvRunnerEnabled, "false")
+	t.Setenv(EnvLabels, "env=override, extra=value")
+
+	enabled := true
+	result := Merge(&Config{
+		Runner: &Runner{Enabled: &enabled},
+		Labels: map[string]string{"env": "local"},
+	})
+	require.NoError(t, ApplyEnv(result))
+	require.False(t, result.Runner.IsEnabled())
+	require.Equal(t, map[string]string{"env": "override", "extra": "value"}, result.Labels)
+}
+
+func TestApplyEnvInvalid(t *testing.T) {
+	t.Setenv(EnvRunnerEnabled, "maybe")
+	require.Error(t, ApplyEnv(&Config{}))
+
+	t.Setenv(EnvRunnerEnabled, "")
+	t.Setenv(EnvLabels, "novalue")
+	require.Error(t, ApplyEnv(&Config{}))
+}
+
+func TestMergeRetries(t *testing.T) {
+	result := Merge(
+		&Config{Retries: []*Retry{
+			{Operation: "*", Attempts: 1},
+			{Operation: "up", Attempts: 2},
+		}},
+		&Config{Retries: []*Retry{
+			{Operation: "up", Attempts: 5},
+		}},
+	)
+
+	require.Len(t, result.Retries, 2)
+	require.Equal(t, 1, result.Retries[0].Attempts)
+	require.Equal(t, 5, result.Retries[1].Attempts)
+}
+
+func TestMergeTargets(t *testing.T) {
+	result := Merge(
+		&Config{Targets: []*Target{
+			{Name: "app", DependsOn: []string{"db"}},
+			{Name: "web", DependsOn: []string{"app"}},
+		}},
+		&Config{Targets: []*Target{
+			{Name: "app", DependsOn: []string{"db", "cache"}},
+		}},
+	)
+
+	require.Len(t, result.Targets, 2)
+	require.Equal(t, []string{"db", "cache"}, result.Targets[0].DependsOn)
+	require.Equal(t, []string{"app"}, result.Targets[1].DependsOn)
+}
+
+func TestMergeAliases(t *testing.T) {
+	result := Merge(
+		&Config{Aliases: &Aliases{
+			Commands:    map[string]string{"start": "up", "ls": "box list"},
+			AllowShadow: true,
+		}},
+		&Config{},
+		&Config{Aliases: &Aliases{
+			Commands: map[string]string{"start": "reload"},
+		}},
+	)
+
+	require.Equal(t, map[string]string{"start": "reload", "ls": "box list"},
+		result.Aliases.Commands)
+	require.False(t, result.Aliases.AllowShadow)
+	require.Nil(t, Merge(&Config{}).Aliases)
+}
+
+func TestMergeCapabilities(t *testing.T) {
+	result := Merge(
+		&Config{Capabilities: &Capabilities{OnMissing: "skip"}},
+		&Config{},
+	)
+	require.Equal(t, "skip", result.Capabilities.OnMissing)
+
+	result = Merge(
+		&Config{Capabilities: &Capabilities{OnMissing: "skip"}},
+		&Config{Capabilities: &Capabilities{OnMissing: "error"}},
+	)
+	require.Equal(t, "error", result.Capabilities.OnMissing)
+}
+
+func TestMergeDefaultProvider(t *testing.T) {
+	result := Merge(
+		&Config{DefaultProvider: "virtualbox"},
+		&Config{DefaultProvider: "docker"},
+		&Config{},
+	)
+	require.Equal(t, "docker", result.DefaultProvider)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// Resolve a command alias to the command it references. Only
+// the root command name is resolved and aliases are not chained.
+// An alias matching an existing command is ignored unless the
+// configuration allows aliases to shadow commands.
+func (b *Basis) resolveCommandAlias(command string) string {
+	cfg, _ := b.Config()
+	if cfg == nil || cfg.Aliases == nil {
+		return command
+	}
+
+	parts := strings.SplitN(command, " ", 2)
+	target, ok := cfg.Aliases.Commands[parts[0]]
+	if !ok || target == "" {
+		return command
+	}
+
+	if !cfg.Aliases.AllowShadow && b.isCommand(parts[0]) {
+		b.logger.Warn("ignoring command alias which shadows existing command",
+			"alias", parts[0],
+			"target", target,
+		)
+
+		return command
+	}
+
+	parts[0] = target
+	resolved := strings.Join(parts, " ")
+	b.logger.Debug("resolved command alias",
+		"command", command,
+		"resolved", resolved,
+	)
+
+	return resolved
+}
+
+// Check if a command plugin exists with the given name
+func (b *Basis) isCommand(name string) bool {
+	names, err := b.plugins.Typed(component.CommandType)
+	if err != nil {
+		return false
+	}
+
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Provide the task with any command alias resolved. If the
+// command is not an alias the original task is returned.
+func (b *Basis) resolveTaskAlias(task *vagrant_server.Job_CommandOp) *vagrant_server.Job_CommandOp {
+	command := b.resolveCommandAlias(task.Command)
+	if command == task.Command {
+		return task
+	}
+
+	resolved := proto.Clone(task).(*vagrant_server.Job_CommandOp)
+	resolved.Command = command
+	if resolved.Component != nil {
+		resolved.Component.Name = b.resolveCommandAlias(resolved.Component.Name)
+	}
+
+	return resolved
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	coremocks "github.com/hashicorp/vagrant-plugin-sdk/core/mocks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/config"
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func testAliasBasis(t *testing.T, shadow bool) *Basis {
+	up := plugin.TestPlugin(t,
+		&coremocks.Command{},
+		plugin.WithPluginName("up"),
+		plugin.WithPluginTypes(component.CommandType),
+	)
+
+	return TestBasis(t,
+		WithPluginManager(plugin.TestManager(t, up)),
+		WithConfig(&config.Config{
+			Aliases: &config.Aliases{
+				Commands: map[string]string{
+					"start": "up",
+					"ls":    "box list",
+					"up":    "reload",
+				},
+				AllowShadow: shadow,
+			},
+		}),
+	)
+}
+
+func TestBasisCommandAlias(t *testing.T) {
+	b := testAliasBasis(t, false)
+
+	require.Equal(t, "up --provision", b.resolveCommandAlias("start --provision"))
+	require.Equal(t, "box list", b.resolveCommandAlias("ls"))
+	require.Equal(t, "status", b.resolveCommandAlias("status"))
+
+	// Aliases cannot shadow existing commands
+	require.Equal(t, "up", b.resolveCommandAlias("up"))
+
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "start",
+		Component: &vagrant_server.Component{Name: "start"},
+	}
+	resolved := b.resolveTaskAlias(task)
+	require.Equal(t, "up", resolved.Command)
+	require.Equal(t, "up", resolved.Component.Name)
+	require.Equal(t, "start", task.Command)
+}
+
+func TestBasisCommandAliasShadow(t *testing.T) {
+	b := testAliasBasis(t, true)
+
+	require.Equal(t, "reload", b.resolveCommandAlias("up"))
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Name of the file within the basis data directory
+// where artifacts are recorded
+const artifactsFile = "artifacts.json"
+
+// Artifact is a file produced by a command, like a log or
+// an exported box
+type Artifact struct {
+	Path      string            `json:"path"`               // absolute path of the artifact
+	Command   string            `json:"command"`            // command which produced the artifact
+	Metadata  map[string]string `json:"metadata,omitempty"` // custom information about the artifact
+	CreatedAt time.Time         `json:"created_at"`         // time the artifact was reported
+}
+
+// Artifacts collects artifacts reported while running a command.
+// A collector is provided to commands as a typed argument so
+// plugins can report what a run produced. Reporting artifacts
+// is optional. Plugins running in their own process request
+// commandargs.Artifacts.
+type Artifacts struct {
+	artifacts []*Artifact
+
+	m sync.Mutex
+}
+
+// NewArtifacts creates a new empty artifacts collector
+func NewArtifacts() *Artifacts {
+	return &Artifacts{artifacts: []*Artifact{}}
+}
+
+// Add records a new artifact at the given path
+func (a *Artifacts) Add(path string, metadata map[string]string) error {
+	if path == "" {
+		return fmt.Errorf("artifact path cannot be empty")
+	}
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	md := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		md[k] = v
+	}
+
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	a.artifacts = append(a.artifacts, &Artifact{
+		Path:      path,
+		Metadata:  md,
+		CreatedAt: time.Now(),
+	})
+
+	return nil
+}
+
+// Artifacts returns all recorded artifacts
+func (a *Artifacts) Artifacts() []*Artifact {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	result := make([]*Artifact, len(a.artifacts))
+	copy(result, a.artifacts)
+
+	return result
+}
+
+// Artifacts returns all artifacts recorded by commands run
+// within the basis
+func (b *Basis) Artifacts() ([]*Artifact, error) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	return b.loadArtifacts()
+}
+
+// Record the artifacts collected while running the command
+func (b *Basis) storeArtifacts(command string, a *Artifacts) error {
+	collected := a.Artifacts()
+	if len(collected) == 0 {
+		return nil
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	existing, err := b.loadArtifacts()
+	if err != nil {
+		return err
+	}
+
+	for _, artifact := range collected {
+		artifact.Command = command
+		existing = append(existing, artifact)
+	}
+
+	data, err := json.Marshal(existing)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(b.dir.DataDir().Join(artifactsFile).String(), data, 0644)
+}
+
+// Read recorded artifacts from the data directory
+func (b *Basis) loadArtifacts() ([]*Artifact, error) {
+	result := []*Artifact{}
+	if b.dir == nil {
+		return result, nil
+	}
+
+	data, err := os.ReadFile(b.dir.DataDir().Join(artifactsFile).String())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return result, nil
+		}
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to read recorded artifacts: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifacts(t *testing.T) {
+	a := NewArtifacts()
+	require.Error(t, a.Add("", nil))
+
+	md := map[string]string{"format": "box"}
+	require.NoError(t, a.Add("package.box", md))
+	md["format"] = "modified"
+
+	result := a.Artifacts()
+	require.Len(t, result, 1)
+	require.True(t, filepath.IsAbs(result[0].Path))
+	require.Equal(t, "box", result[0].Metadata["format"])
+}
+
+func TestBasisArtifacts(t *testing.T) {
+	b := TestBasis(t)
+
+	result, err := b.Artifacts()
+	require.NoError(t, err)
+	require.Empty(t, result)
+
+	// Nothing is recorded when no artifacts are reported
+	require.NoError(t, b.storeArtifacts("status", NewArtifacts()))
+
+	a := NewArtifacts()
+	require.NoError(t, a.Add("/tmp/package.box", nil))
+	require.NoError(t, b.storeArtifacts("package", a))
+
+	a = NewArtifacts()
+	require.NoError(t, a.Add("/tmp/debug.log", map[string]string{"type": "log"}))
+	require.NoError(t, b.storeArtifacts("up", a))
+
+	result, err = b.Artifacts()
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, "package", result[0].Command)
+	require.Equal(t, "/tmp/package.box", result[0].Path)
+	require.Equal(t, "up", result[1].Command)
+	require.Equal(t, "log", result[1].Metadata["type"])
+}
@@ -6,11 +6,13 @@ package core
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/go-hclog"
@@ -19,9 +21,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/hashicorp/vagrant-plugin-sdk/component"
-	vconfig "github.com/hashicorp/vagrant-plugin-sdk/config"
 	"github.com/hashicorp/vagrant-plugin-sdk/core"
 	"github.com/hashicorp/vagrant-plugin-sdk/datadir"
 	"github.com/hashicorp/vagrant-plugin-sdk/helper/path"
@@ -29,12 +31,12 @@ import (
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cacher"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cleanup"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/dynamic"
-	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/protomappers"
 	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
 	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
 
 	"github.com/hashicorp/vagrant/internal/config"
 	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/protocolversion"
 	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
 	"github.com/hashicorp/vagrant/internal/serverclient"
 )
@@ -46,27 +48,84 @@ import (
 // finished with the basis to properly clean
 // up any open resources.
 type Basis struct {
+	appliedOpts   map[string]struct{}         // names of options which were applied
 	basis         *vagrant_server.Basis       // stored basis data
 	boxCollection *BoxCollection              // box collection for this basis
 	cache         cacher.Cache                // local basis cache
+	cancelTimeout time.Duration               // time allowed for each cancellation cleanup
+	capMissing    *CapabilityMissingMode      // behavior when a required capability is missing
+	capRequired   []CapabilityRequirement     // capabilities required by operations
 	cleaner       cleanup.Cleanup             // cleanup tasks to be run on close
 	client        *serverclient.VagrantClient // client to vagrant server
+	closeTimeout  time.Duration               // maximum time allowed for close
+	closers       *closerTracker              // tracks completion of closers
+	cmdMiddleware []CommandMiddleware         // middleware wrapping commands
+	colorMode     ColorMode                   // color output mode for the UI
+	componentHook ComponentHook               // observes created and closed components
+	config        *config.Config              // effective merged configuration
+	configPath    string                      // path of the configuration file
+	configWatch   bool                        // reload configuration when changed
+	configLoader  ConfigLoader                // custom loader for path configuration
 	corePlugins   *CoreManager                // manager for the core plugin types
+	credProvider  CredentialProvider          // supplies credentials to plugins
 	ctx           context.Context             // local context
+	defaultProv   string                      // provider requested as the default
 	dir           *datadir.Basis              // data directory for basis
+	ephemeral     bool                        // basis is not saved when closed
+	events        *eventStream                // lifecycle event subscribers
 	factory       *Factory                    // scope factory
+	hooks         *basisHooks                 // lifecycle callbacks for embedders
+	fallback      FactoryFallback             // provides plugins for unknown components
+	globalConfig  *config.Config              // machine wide configuration
+	hostDetect    *hostDetector               // ensures host detection runs once
+	idempotent    *idempotencyCache           // results of operations by idempotency key
 	index         *TargetIndex                // index of targets within basis
 	jobInfo       *component.JobInfo          // jobInfo is the base job info for executed functions
+	labels        map[string]string           // labels applied when initialized
+	lastProgress  *ProgressState              // final progress of the last command
+	logFields     []interface{}               // fields attached to all loggers
 	logger        hclog.Logger                // basis specific logger
+	machineUI     bool                        // format UI output as machine readable
+	mapperDebug   bool                        // log mapper resolution details on failure
+	mapperSet     []*argmapper.Func           // replacement for the default mapper set
 	mappers       []*argmapper.Func           // mappers for basis
+	middleware    []OperationMiddleware       // middleware wrapping operations
+	operations    *operationRegistry          // in-flight operations
+	optionMappers []*argmapper.Func           // mappers added with WithMappers
+	output        *outputBuffer               // captures recent operation output
+	outputFilter  OutputFilter                // transforms each line of output
+	outputLimit   int                         // bytes of operation output retained
+	pathConfig    *config.Config              // configuration for the basis path
+	pluginCap     *pluginCap                  // limits and reaps plugin processes
+	pluginEnv     *plugin.Env                 // environment for plugin processes
+	pluginLimit   int                         // maximum concurrently running plugin processes
+	pluginPolicy  *PluginPolicy               // restricts which plugins may be used
 	plugins       *plugin.Manager             // basis scoped plugin manager
+	progress      ProgressReporter            // receives progress events for long operations
+	projectCtor   ProjectConstructor          // creates initial project instances
+	projects      *projectCache               // loaded projects evicted when unused
+	renderer      Renderer                    // formats messages written to the UI
 	ready         bool                        // flag that instance is ready
+	resourceId    string                      // resource id of the basis to load
+	retry         *operationRetry             // retry policy for operations
+	retryTypes    map[string]*operationRetry  // retry policies for specific operation types
+	rootPath      string                      // basis path the data directory and configuration are derived from
+	scratch       *scratchDirs                // scratch directories removed when done
 	seedValues    *core.Seeds                 // seed values to be applied when running commands
+	setupDeadline time.Time                   // deadline for server requests during construction
+	setupTimeout  time.Duration               // time allowed for construction
 	statebag      core.StateBag               // statebag to persist values
+	stdin         io.Reader                   // input provided to commands
+	traceCtx      context.Context             // caller context providing trace values
 	ui            terminal.UI                 // basis UI (non-prefixed)
+	uiBase        terminal.UI                 // UI the basis UI wraps
+	uiConsole     bool                        // base UI is a console UI created by the basis
+	uiStatus      *uiStatusTracker            // tracks calls using UI status
 	vagrantfile   *Vagrantfile                // vagrantfile instance for basis
+	validators    []ConfigValidator           // custom configuration validation rules
 
-	m sync.Mutex
+	configM sync.RWMutex
+	m       sync.Mutex
 }
 
 // NewBasis creates a new Basis with the given options.
@@ -81,12 +140,23 @@ func NewBasis(ctx context.Context, opts ...BasisOption) (*Basis, error) {
 		},
 		cache:      cacher.New(),
 		cleaner:    cleanup.New(),
+		closers:    &closerTracker{},
 		ctx:        ctx,
+		events:     newEventStream(),
+		hostDetect: &hostDetector{},
+		idempotent: newIdempotencyCache(0),
 		logger:     hclog.L(),
 		mappers:    []*argmapper.Func{},
 		jobInfo:    &component.JobInfo{},
+		operations: newOperationRegistry(),
+		pluginCap:  &pluginCap{},
+		progress:   noopProgressReporter{},
+		projects:   newProjectCache(),
+		retryTypes: map[string]*operationRetry{},
+		scratch:    newScratchDirs(),
 		seedValues: core.NewSeeds(),
 		statebag:   NewStateBag(),
+		uiStatus:   newUIStatusTracker(),
 	}
 
 	for _, opt := range opts {
@@ -94,21 +164,117 @@ func NewBasis(ctx context.Context, opts ...BasisOption) (*Basis, error) {
 			err = multierror.Append(err, oerr)
 		}
 	}
+	if oerr := b.checkOptionConflicts(); oerr != nil {
+		err = multierror.Append(err, oerr)
+	}
 
 	if err != nil {
 		return nil, err
 	}
+	b.ctx = b.withTraceContext(b.ctx)
+
+	// Server requests are only made once all options are
+	// applied so the construction timeout bounds all of them
+	if b.setupTimeout > 0 {
+		b.setupDeadline = time.Now().Add(b.setupTimeout)
+	}
+
+	if b.resourceId != "" {
+		if err = b.loadResourceId(); err != nil {
+			return nil, b.finishSetup(err)
+		}
+	}
+
+	if b.rootPath != "" {
+		if err = b.loadBasisPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	// If a custom loader is set, it provides the path configuration
+	if b.configLoader != nil {
+		if b.pathConfig, err = b.configLoader(); err != nil {
+			return nil, fmt.Errorf("failed to load configuration: %w", err)
+		}
+	}
+
+	if b.config, err = b.mergeConfig(b.pathConfig); err != nil {
+		return nil, err
+	}
+
+	// Apply any retry policies from the configuration which
+	// were not explicitly provided
+	for _, rc := range b.config.Retries {
+		r, err := retryFromConfig(rc)
+		if err != nil {
+			return nil, err
+		}
+		if r.operation == "" && b.retry == nil {
+			b.retry = r
+		}
+		if _, ok := b.retryTypes[r.operation]; r.operation != "" && !ok {
+			b.retryTypes[r.operation] = r
+		}
+	}
+
+	// Use the plugin restrictions from the configuration if
+	// a policy was not explicitly provided
+	if b.pluginPolicy == nil && b.config.Plugins != nil {
+		b.pluginPolicy = &PluginPolicy{
+			Allow: b.config.Plugins.Allow,
+			Deny:  b.config.Plugins.Deny,
+		}
+		if err = b.pluginPolicy.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Use the capability setting from the configuration if a
+	// mode was not explicitly provided
+	if b.capMissing == nil && b.config.Capabilities != nil {
+		mode, err := parseCapabilityMissingMode(b.config.Capabilities.OnMissing)
+		if err != nil {
+			return nil, err
+		}
+		b.capMissing = &mode
+	}
 
 	return b, nil
 }
 
-func (b *Basis) Init() error {
-	var err error
+// Merge the path configuration with the other configuration
+// sources and validate the result. Precedence from lowest to
+// highest is global, path, and then environment.
+func (b *Basis) mergeConfig(pathConfig *config.Config) (*config.Config, error) {
+	c := config.Merge(b.globalConfig, pathConfig)
+	if err := config.ApplyEnv(c); err != nil {
+		return nil, err
+	}
+
+	// Validate the effective configuration. All validators are
+	// run so every failure can be reported at once.
+	var verr error
+	if err := c.Validate(); err != nil {
+		verr = multierror.Append(verr, err)
+	}
+	for _, v := range b.validators {
+		if err := v(c); err != nil {
+			verr = multierror.Append(verr, err)
+		}
+	}
+	if verr != nil {
+		return nil, fmt.Errorf("configuration validation failed: %w", verr)
+	}
+
+	return c, nil
+}
 
+func (b *Basis) Init() (err error) {
 	// If ready then Init was already run
 	if b.ready {
 		return nil
 	}
+	defer func() { err = b.finishSetup(err) }()
 
 	// Client is required to be provided
 	if b.client == nil {
@@ -125,7 +291,15 @@ func (b *Basis) Init() error {
 	b.plugins = b.plugins.Sub("basis")
 
 	// Configure our logger
-	b.logger = b.logger.ResetNamed("vagrant.core.basis")
+	b.logger = b.logger.ResetNamed("vagrant.core.basis").With(b.logFields...)
+	b.plugins.AddLoggerFields(b.logFields...)
+
+	// If no path was provided but a data directory was,
+	// derive the path from the directory so the name and
+	// path remain distinct
+	if b.basis.Path == "" && b.dir != nil {
+		b.basis.Path = b.dir.ConfigDir().String()
+	}
 
 	// Attempt to reload the basis to populate our
 	// data. If the basis is not found, create it.
@@ -135,8 +309,22 @@ func (b *Basis) Init() error {
 		if !ok || stat.Code() != codes.NotFound {
 			return err
 		}
-		// Project doesn't exist so save it to persist
-		if err = b.Save(); err != nil {
+		// Project doesn't exist so save it to persist unless
+		// the basis is ephemeral
+		if !b.ephemeral {
+			if err = b.Save(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Now that the resource id is known include it in log lines
+	b.logger = b.logger.With(b.loggerFields()...)
+	b.plugins.AddLoggerFields(b.loggerFields()...)
+
+	// Apply any labels provided when the basis was created
+	for k, v := range b.labels {
+		if err = b.SetLabel(k, v); err != nil {
 			return err
 		}
 	}
@@ -152,29 +340,36 @@ func (b *Basis) Init() error {
 
 	// If the basis directory is unset, set it
 	if b.dir == nil {
-		if b.dir, err = datadir.NewBasis(b.basis.Name); err != nil {
+		if b.dir, err = datadir.NewBasis(b.dataDirIdent()); err != nil {
 			return err
 		}
 	}
 
-	// If the mappers aren't already set, load known mappers
-	if len(b.mappers) == 0 {
-		b.mappers, err = argmapper.NewFuncList(protomappers.All,
-			argmapper.Logger(dynamic.Logger),
-		)
-
-		if err != nil {
-			return err
-		}
-
-		locals, err := argmapper.NewFuncList(Mappers, argmapper.Logger(dynamic.Logger))
-		if err != nil {
-			return err
-		}
+	// Upgrade the data directory if it uses an older layout
+	if err = b.migrateDataDir(); err != nil {
+		return err
+	}
 
-		b.mappers = append(b.mappers, locals...)
+	// Load the base set of mappers. If a replacement set was
+	// provided use it, otherwise use the known proto mappers
+	defaults, locals, err := defaultMappers()
+	if err != nil {
+		return err
+	}
+	base := b.mapperSet
+	if base == nil {
+		base = defaults
 	}
 
+	// The local mappers are always included as they are
+	// required for converting command information. Any
+	// mappers added via options are appended last. A new
+	// list is built since the default mappers are shared.
+	mappers := make([]*argmapper.Func, 0, len(base)+len(locals)+len(b.optionMappers))
+	mappers = append(append(mappers, base...), locals...)
+	b.mapperSet = base
+	b.mappers = append(mappers, b.optionMappers...)
+
 	// Create the manager for handling core plugins
 	b.corePlugins = NewCoreManager(b.ctx, b.logger)
 
@@ -186,9 +381,39 @@ func (b *Basis) Init() error {
 		basis:  b,
 	}
 
-	// If no UI was provided, initialize a console UI
-	if b.ui == nil {
-		b.ui = terminal.ConsoleUI(b.ctx)
+	// If no UI was provided, initialize a console UI. The
+	// wrapping UIs are built from the base UI so they are not
+	// stacked when a basis is cloned.
+	if b.uiBase == nil {
+		b.uiBase = terminal.ConsoleUI(b.ctx)
+		b.uiConsole = true
+	}
+	b.ui = b.uiBase
+	if b.uiConsole {
+		b.configureColor()
+	}
+
+	// Wrap the UI if machine readable output was requested
+	if b.machineUI && !b.ui.MachineReadable() {
+		b.ui = NewMachineReadableUI(b.ui, "")
+	}
+
+	// Capture recent output for error reporting
+	if b.outputLimit < 1 {
+		b.outputLimit = defaultOutputLimit
+	}
+	b.output = newOutputBuffer(b.outputLimit)
+
+	// Render output after it is captured so the retained
+	// output contains the original messages
+	if b.renderer != nil {
+		b.ui = newRenderUI(b.ui, b.renderer)
+	}
+	b.ui = newCaptureUI(b.ui, b.output)
+
+	// Filter output before it is captured or displayed
+	if b.outputFilter != nil {
+		b.ui = newFilterUI(b.ui, b.outputFilter)
 	}
 
 	// Create our vagrantfile
@@ -225,6 +450,9 @@ func (b *Basis) Init() error {
 	// Configure plugins to have seeds set
 	b.plugins.Configure(b.setPluginSeeds)
 
+	// Configure plugins with the UI color setting
+	b.plugins.Configure(b.setPluginColor)
+
 	// If we have legacy vagrant loaded, configure managers
 	if b.plugins.LegacyEnabled() {
 		// Configure plugins to have plugin manager set (used by legacy)
@@ -234,6 +462,16 @@ func (b *Basis) Init() error {
 		b.plugins.Configure(b.setPluginCoreManager)
 	}
 
+	// Limit plugin processes and stop idle processes if requested
+	b.applyPluginCap()
+	b.startPluginReaper()
+
+	// Apply the environment for plugin processes prior to
+	// any plugins being launched
+	if b.pluginEnv != nil {
+		b.plugins.SetEnv(b.pluginEnv)
+	}
+
 	// Load any plugins that may be available
 	if err = b.plugins.Discover(b.dir.ConfigDir().Join("plugins")); err != nil {
 		b.logger.Error("basis setup failed during plugin discovery",
@@ -287,10 +525,17 @@ func (b *Basis) Init() error {
 		return b.index.Close()
 	})
 
-	// Save ourself when closed
-	b.Closer(func() error {
-		return b.Save()
-	})
+	// Save ourself when closed unless the basis is ephemeral
+	if !b.ephemeral {
+		b.Closer(func() error {
+			return b.Save()
+		})
+	}
+
+	// Reload the configuration when it is modified
+	if b.configWatch {
+		b.watchConfig()
+	}
 
 	// Mark basis as being initialized
 	b.ready = true
@@ -299,19 +544,135 @@ func (b *Basis) Init() error {
 	b.logger = b.logger.With("basis", b)
 	b.logger.Info("basis initialized")
 
-	return nil
+	return b.hooks.afterLoad(b)
+}
+
+// Clone creates a new basis which shares the client, plugins,
+// factory, mappers, and data directory of this basis. The clone
+// has its own state, cache, events, idempotency cache, and cleanup
+// tasks. Closing the clone will not close resources owned by this
+// basis. The plugin startup limit is shared since the plugins are
+// launched from the same plugin manager.
+//
+// The clone uses the same server record as this basis but is
+// ephemeral, so it is never saved and cannot overwrite the record.
+// The clone is initialized like a new basis, so lifecycle hooks are
+// run for the clone. Plugin discovery is skipped since the plugin
+// directory has already been discovered. Any options provided are
+// applied after the shared values are set.
+func (b *Basis) Clone(opts ...BasisOption) (*Basis, error) {
+	if !b.ready {
+		return nil, fmt.Errorf("cannot clone basis which is not initialized")
+	}
+
+	copts := []BasisOption{
+		FromBasis(b),
+		WithFactory(b.factory),
+		WithJobInfo(b.jobInfo),
+		WithBasisDataDir(b.dir),
+		WithMapperSet(b.mapperSet...),
+		WithMappers(b.optionMappers...),
+		func(c *Basis) error {
+			c.basis = proto.Clone(b.basis).(*vagrant_server.Basis)
+			c.cancelTimeout = b.cancelTimeout
+			c.capMissing = b.capMissing
+			c.capRequired = append(c.capRequired, b.capRequired...)
+			c.closeTimeout = b.closeTimeout
+			c.cmdMiddleware = append(c.cmdMiddleware, b.cmdMiddleware...)
+			c.colorMode = b.colorMode
+			c.componentHook = b.componentHook
+			c.configPath = b.configPath
+			c.configWatch = b.configWatch
+			c.credProvider = b.credProvider
+			c.defaultProv = b.defaultProv
+			c.ephemeral = true
+			c.fallback = b.fallback
+			c.globalConfig = b.globalConfig
+			if b.hooks != nil {
+				c.hooks = &basisHooks{BasisHooks: b.hooks.BasisHooks}
+			}
+			c.idempotent = newIdempotencyCache(b.idempotent.ttl)
+			c.logFields = b.logFields
+			c.machineUI = b.machineUI
+			c.mapperDebug = b.mapperDebug
+			c.middleware = append(c.middleware, b.middleware...)
+			c.outputFilter = b.outputFilter
+			c.outputLimit = b.outputLimit
+			c.pathConfig = b.pathConfig
+			c.pluginCap = b.pluginCap.copy()
+			c.pluginEnv = b.pluginEnv
+			c.pluginLimit = b.pluginLimit
+			c.pluginPolicy = b.pluginPolicy
+			c.progress = b.progress
+			c.projectCtor = b.projectCtor
+			c.projects.max = b.projects.max
+			c.projects.onEvict = b.projects.onEvict
+			c.renderer = b.renderer
+			c.retry = b.retry
+			c.stdin = b.stdin
+			c.traceCtx = b.traceCtx
+			for k, v := range b.retryTypes {
+				c.retryTypes[k] = v
+			}
+			return nil
+		},
+	}
+
+	c, err := NewBasis(b.ctx, append(copts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.Init(); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	b.logger.Debug("created basis clone",
+		"clone", c,
+	)
+
+	return c, nil
+}
+
+// Fields attached to all loggers derived from the basis
+func (b *Basis) loggerFields() []interface{} {
+	fields := append([]interface{}{}, b.logFields...)
+	if b.basis != nil && b.basis.ResourceId != "" {
+		fields = append(fields, "basis_resource_id", b.basis.ResourceId)
+	}
+
+	return fields
+}
+
+// Environment for plugin processes, created if unset
+func (b *Basis) ensurePluginEnv() *plugin.Env {
+	if b.pluginEnv == nil {
+		b.pluginEnv = &plugin.Env{Vars: map[string]string{}}
+	}
+
+	return b.pluginEnv
 }
 
-// Provide nice output in logger
+// Provide nice output in logger. Only identifying information
+// is included so logging the basis never touches internal state.
 func (b *Basis) String() string {
-	return fmt.Sprintf("core.Basis:[name: %s resource_id: %s address: %p]",
-		b.basis.Name, b.basis.ResourceId, b)
+	if b.basis == nil {
+		return "basis(name=, id=, projects=0)"
+	}
+
+	return fmt.Sprintf("basis(name=%s, id=%s, projects=%d)",
+		b.basis.Name, b.basis.ResourceId, len(b.basis.Projects))
 }
 
-// Config implements core.Basis
-func (b *Basis) Config() (*vconfig.Vagrantfile, error) {
-	return nil, fmt.Errorf("not implemented")
-	//return vconfig.DecodeVagrantfile(b.basis.Configuration.Finalized)
+// Config returns the effective configuration for the basis. This
+// is the global configuration merged with the path configuration
+// and any environment overrides.
+func (b *Basis) Config() (*config.Config, error) {
+	b.configM.RLock()
+	defer b.configM.RUnlock()
+
+	return b.config, nil
 }
 
 // CWD implements core.Basis
@@ -336,14 +697,27 @@ func (b *Basis) DefaultPrivateKey() (path path.Path, err error) {
 
 // DefaultProvider implements core.Basis
 // This is a subset of the Project.DefaultProvider() algorithm, just the parts
-// that make sense when you don't have a Vagrantfile
+// that make sense when you don't have a Vagrantfile. The provider is chosen
+// from, in order:
+//
+//  1. The WithDefaultProvider option
+//  2. The VAGRANT_DEFAULT_PROVIDER environment variable
+//  3. The default_provider configuration value
+//  4. The first usable provider in VAGRANT_PREFERRED_PROVIDERS
+//  5. The usable provider with the highest priority
+//
+// A provider requested by 1-3 must be installed and usable. If it is
+// not installed ErrProviderNotInstalled is returned, and if it is not
+// usable ErrProviderNotUsable is returned.
 func (b *Basis) DefaultProvider() (string, error) {
 	logger := b.logger.Named("default-provider")
 	logger.Debug("Searching for default provider")
 
-	defaultProvider := os.Getenv("VAGRANT_DEFAULT_PROVIDER")
-	if defaultProvider != "" {
-		logger.Debug("Using VAGRANT_DEFAULT_PROVIDER", "provider", defaultProvider)
+	if defaultProvider, source := b.requestedProvider(); defaultProvider != "" {
+		if err := b.checkProvider(defaultProvider, true); err != nil {
+			return "", err
+		}
+		logDefaultProvider(logger, defaultProvider, source)
 		return defaultProvider, nil
 	}
 
@@ -408,16 +782,14 @@ func (b *Basis) DefaultProvider() (string, error) {
 	for _, pp := range preferredProviders {
 		for _, up := range usableProviders {
 			if pp == up.Name {
-				logger.Debug("Using preffered provider found in usable list",
-					"provider", pp)
+				logDefaultProvider(logger, pp, "preferred and usable")
 				return pp, nil
 			}
 		}
 	}
 
 	if len(usableProviders) > 0 {
-		logger.Debug("Using the first provider from the usable list",
-			"provider", usableProviders[0])
+		logDefaultProvider(logger, usableProviders[0].Name, "highest priority usable")
 		return usableProviders[0].Name, nil
 	}
 
@@ -453,7 +825,19 @@ func (b *Basis) Ref() interface{} {
 	return &vagrant_plugin_sdk.Ref_Basis{
 		ResourceId: b.basis.ResourceId,
 		Name:       b.Name(),
+		Path:       b.basis.Path,
+	}
+}
+
+// Identifier used for resolving the basis data directory. The
+// directory name of the basis path is used when available since
+// the name of the basis may differ from its directory.
+func (b *Basis) dataDirIdent() string {
+	if b.basis.Path != "" {
+		return filepath.Base(b.basis.Path)
 	}
+
+	return b.basis.Name
 }
 
 // Custom name defined for this basis
@@ -480,6 +864,40 @@ func (b *Basis) Client() *serverclient.VagrantClient {
 	return b.client
 }
 
+// Ping verifies the Vagrant server is reachable and that its
+// API version is compatible with this client. The returned error
+// will wrap ErrServerUnreachable, ErrServerUnauthenticated, or
+// ErrServerIncompatible when applicable.
+func (b *Basis) Ping(ctx context.Context) error {
+	resp, err := b.client.GetVersionInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		b.logger.Debug("failed to ping vagrant server",
+			"error", err,
+		)
+
+		switch status.Code(err) {
+		case codes.Unauthenticated, codes.PermissionDenied:
+			return fmt.Errorf("%w: %s", ErrServerUnauthenticated, err)
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+			return fmt.Errorf("%w: %s", ErrServerUnreachable, err)
+		default:
+			return err
+		}
+	}
+
+	vsn, err := protocolversion.Negotiate(protocolversion.Current().Api, resp.Info.Api)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrServerIncompatible, err)
+	}
+
+	b.logger.Trace("vagrant server ping complete",
+		"version", resp.Info.Version,
+		"api_version", vsn,
+	)
+
+	return nil
+}
+
 func (b *Basis) State() *StateBag {
 	return b.statebag.(*StateBag)
 }
@@ -501,14 +919,59 @@ func (b *Basis) Boxes() (bc core.BoxCollection, err error) {
 	return b.boxCollection, nil
 }
 
+// HostDetection is the result of detecting the host
+// for the current platform.
+type HostDetection struct {
+	core.Host
+
+	Name     string // name of the host plugin
+	Priority int    // detection priority (number of parent hosts)
+	Detected bool   // host was detected for the current platform
+}
+
 // Returns the detected host for the current platform
 func (b *Basis) Host() (host core.Host, err error) {
-	if h := b.cache.Get("host"); h != nil {
-		return h.(core.Host), nil
+	d, err := b.DetectHost(b.ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	return d.Host, nil
+}
+
+// HostSupports checks if the detected host for the current
+// platform provides the named capability
+func (b *Basis) HostSupports(capability string) (bool, error) {
+	h, err := b.Host()
+	if err != nil {
+		return false, fmt.Errorf("cannot check host capability %s: %w", capability, err)
+	}
+
+	return h.HasCapability(capability)
+}
+
+// DetectHost returns the detected host for the current platform
+// along with information about which plugin was chosen. Detection
+// is only performed once, and the result (including any error) is
+// shared by all callers until InvalidateHost is called.
+func (b *Basis) DetectHost(ctx context.Context) (*HostDetection, error) {
+	return b.hostDetect.detect(func() (*HostDetection, error) {
+		return b.detectHost(ctx)
+	})
+}
+
+// InvalidateHost discards the detected host so detection will
+// be performed again on the next request
+func (b *Basis) InvalidateHost() {
+	b.hostDetect.reset()
+	b.cache.Delete("host")
+	b.cache.Delete("host-detection")
+}
+
+// Perform host detection for the current platform
+func (b *Basis) detectHost(ctx context.Context) (*HostDetection, error) {
 	// TODO(spox): this is for when we have implemented vagrantfile conversions
-	// bConfig, err := b.Config()
+	// bConfig, err := vconfig.DecodeVagrantfile(b.basis.Configuration.Finalized)
 	// if err != nil {
 	// 	b.logger.Error("failed to get configuration for basis")
 	// 	return
@@ -531,15 +994,24 @@ func (b *Basis) Host() (host core.Host, err error) {
 	// 	}
 	// }
 
+	// Check if the host detected on a previous run is still valid
+	if result := b.cachedHostDetection(ctx); result != nil {
+		b.cache.Register("host", result.Host)
+		b.cache.Register("host-detection", result)
+
+		return result, nil
+	}
+
 	// If a host is not defined in the Vagrantfile, try to detect it
-	hosts, err := b.typeComponents(b.ctx, component.HostType)
+	hosts, err := b.typeComponents(ctx, component.HostType)
 	if err != nil {
 		return nil, err
 	}
+	if len(hosts) == 0 {
+		return nil, ErrNoHostFactory
+	}
 
-	var result core.Host
-	var result_name string
-	var numParents int
+	var result *HostDetection
 
 	for name, h := range hosts {
 		host := h.Value.(core.Host)
@@ -553,33 +1025,36 @@ func (b *Basis) Host() (host core.Host, err error) {
 
 			continue
 		}
-		if result == nil {
-			if detected {
-				result = host
-				result_name = name
-				numParents = h.plugin.ParentCount()
-			}
+		if !detected {
 			continue
 		}
 
-		if detected {
-			hp := h.plugin.ParentCount()
-			if hp > numParents {
-				result = host
-				result_name = name
-				numParents = hp
+		// Hosts with more parents are more specific so
+		// they take precedence
+		hp := h.plugin.ParentCount()
+		if result == nil || hp > result.Priority {
+			result = &HostDetection{
+				Host:     host,
+				Name:     name,
+				Priority: hp,
+				Detected: true,
 			}
 		}
 	}
 
 	if result == nil {
-		return nil, fmt.Errorf("failed to detect host plugin for current platform")
+		return nil, ErrHostNotDetected
 	}
 
 	b.logger.Info("host detection complete",
-		"name", result_name)
+		"name", result.Name,
+		"priority", result.Priority,
+		"candidates", len(hosts),
+	)
 
-	b.cache.Register("host", result)
+	b.cache.Register("host", result.Host)
+	b.cache.Register("host-detection", result)
+	b.storeHostDetection(result)
 
 	return result, nil
 }
@@ -590,7 +1065,8 @@ func (b *Basis) Host() (host core.Host, err error) {
 func (b *Basis) RunInit() (result *vagrant_server.Job_InitResult, err error) {
 	b.logger.Debug("running init for basis")
 	result = &vagrant_server.Job_InitResult{
-		Commands: []*vagrant_plugin_sdk.Command_CommandInfo{},
+		Commands:     []*vagrant_plugin_sdk.Command_CommandInfo{},
+		CommandFlags: []*vagrant_server.Job_CommandFlags{},
 	}
 	ctx := context.Background()
 
@@ -601,21 +1077,32 @@ func (b *Basis) RunInit() (result *vagrant_server.Job_InitResult, err error) {
 
 	for _, c := range cmds {
 		fn := c.Value.(component.Command).CommandInfoFunc()
-		// See core.JobCommandProto
 		raw, err := b.callDynamicFunc(ctx, b.logger, fn,
-			(*[]*vagrant_plugin_sdk.Command_CommandInfo)(nil),
+			(**component.CommandInfo)(nil),
 			argmapper.Typed(b.ctx),
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		// The command info proto does not include required
+		// flags so they are provided separately
+		info := raw.(*component.CommandInfo)
+		cinfos, err := JobCommandProto(info)
+		if err != nil {
+			return nil, err
+		}
+		cflags, err := JobCommandFlagsProto(info)
+		if err != nil {
+			return nil, err
+		}
+
 		// Primary comes from plugin options so add that to CommandInfo here
-		cinfos := raw.([]*vagrant_plugin_sdk.Command_CommandInfo)
 		copts := c.Options.(*component.CommandOptions)
 		cinfos[0].Primary = copts.Primary
 
 		result.Commands = append(result.Commands, cinfos...)
+		result.CommandFlags = append(result.CommandFlags, cflags...)
 	}
 
 	return
@@ -623,15 +1110,40 @@ func (b *Basis) RunInit() (result *vagrant_server.Job_InitResult, err error) {
 
 // Register functions to be called when closing this basis
 func (b *Basis) Closer(c func() error) {
-	b.cleaner.Do(c)
+	i := b.closers.add(closerName(c))
+	b.cleaner.Do(func() error {
+		defer b.closers.complete(i)
+		return c()
+	})
 }
 
 // Close is called to clean up resources allocated by the basis.
 // This should be called and blocked on to gracefully stop the basis.
+// If a shutdown timeout is set, Close returns once the timeout is
+// reached even if closers have not completed. The basis is always
+// closed, and any error from the before close hook is returned
+// along with errors from the closers.
 func (b *Basis) Close() (err error) {
 	b.logger.Debug("closing basis")
 
-	return b.cleaner.Close()
+	if herr := b.hooks.beforeClose(b); herr != nil {
+		b.logger.Warn("before close hook failed",
+			"error", herr,
+		)
+		err = multierror.Append(err, herr)
+	}
+
+	var cerr error
+	if b.closeTimeout > 0 {
+		cerr = b.closeWithTimeout()
+	} else {
+		cerr = b.cleaner.Close()
+	}
+	if cerr != nil {
+		err = multierror.Append(err, cerr)
+	}
+
+	return
 }
 
 // Reload basis data
@@ -643,20 +1155,68 @@ func (b *Basis) Reload() (err error) {
 		return status.Error(codes.NotFound, "basis does not exist")
 	}
 
-	result, err := b.client.FindBasis(b.ctx,
+	ctx, cancel := b.requestCtx()
+	defer cancel()
+
+	result, err := b.client.FindBasis(ctx,
 		&vagrant_server.FindBasisRequest{
 			Basis: b.basis,
 		},
 	)
 
 	if err != nil {
-		return
+		return newServerError(err, "find basis", b.basis.ResourceId)
 	}
 
 	b.basis = result.Basis
+	b.cache.Delete(environmentCacheKey)
 	return
 }
 
+// Reindex reloads the basis from the server and loads any projects
+// which have been added to the basis since it was last loaded, such
+// as projects added by another process. Projects which are already
+// loaded are left intact.
+func (b *Basis) Reindex() error {
+	b.m.Lock()
+	known := map[string]struct{}{}
+	for _, ref := range b.basis.Projects {
+		known[ref.ResourceId] = struct{}{}
+	}
+	b.m.Unlock()
+
+	if err := b.Reload(); err != nil {
+		return err
+	}
+
+	b.m.Lock()
+	refs := append([]*vagrant_plugin_sdk.Ref_Project{}, b.basis.Projects...)
+	b.m.Unlock()
+
+	for _, ref := range refs {
+		if _, ok := known[ref.ResourceId]; ok {
+			continue
+		}
+		if p, ok := b.factory.fetch(ref.ResourceId); ok && !p.(*Project).Closed() {
+			continue
+		}
+
+		b.logger.Debug("loading project added to basis",
+			"project", ref.Name,
+			"resource_id", ref.ResourceId,
+		)
+
+		if _, err := b.factory.NewProject(
+			WithBasis(b),
+			WithProjectRef(ref),
+		); err != nil {
+			return fmt.Errorf("failed to load project %s: %w", ref.Name, err)
+		}
+	}
+
+	return nil
+}
+
 // Saves the basis to the db
 func (b *Basis) Save() (err error) {
 	b.m.Lock()
@@ -675,14 +1235,17 @@ func (b *Basis) Save() (err error) {
 		}
 	}
 
-	result, err := b.Client().UpsertBasis(b.ctx,
+	ctx, cancel := b.requestCtx()
+	defer cancel()
+
+	result, err := b.Client().UpsertBasis(ctx,
 		&vagrant_server.UpsertBasisRequest{
 			Basis: b.basis})
 
 	if err != nil {
 		b.logger.Trace("failed to save basis",
 			"error", err)
-		return err
+		return newServerError(err, "save basis", b.basis.ResourceId)
 	}
 
 	b.basis = result.Basis
@@ -704,41 +1267,84 @@ func (b *Basis) Components(ctx context.Context) ([]*Component, error) {
 
 // Runs a specific task via component which matches the task's
 // component name. This is the entry point for running commands.
-func (b *Basis) Run(ctx context.Context, task *vagrant_server.Job_CommandOp) (err error) {
-	b.logger.Debug("running new command",
-		"command", task)
+// Any warnings reported by the command are returned and displayed.
+func (b *Basis) Run(ctx context.Context, task *vagrant_server.Job_CommandOp) (warnings []string, err error) {
+	return b.runCommand(ctx, task, task.CliArgs)
+}
 
-	// Build the component to run
-	cmd, err := b.component(ctx, component.CommandType, task.Component.Name)
+// RunWithParams runs the task using the provided command parameters
+// instead of the task's CLI arguments. This allows callers to run
+// commands with structured values without building a command line.
+func (b *Basis) RunWithParams(
+	ctx context.Context, // context for the command
+	task *vagrant_server.Job_CommandOp, // task to run
+	params *component.CommandParams, // arguments for the command
+) ([]string, error) {
+	if params == nil {
+		return nil, fmt.Errorf("command parameters cannot be nil")
+	}
+
+	args, err := CommandArgumentsProto(params)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("invalid command parameters: %w", err)
 	}
 
-	fn := cmd.Value.(component.Command).ExecuteFunc(
-		strings.Split(task.Command, " "))
-	result, err := b.callDynamicFunc(ctx, b.logger, fn, (*int32)(nil),
-		argmapper.Typed(task.CliArgs, b.jobInfo, b.dir, b.ctx, b.ui),
-		argmapper.ConverterFunc(cmd.mappers...),
-	)
+	return b.runCommand(ctx, task, args)
+}
 
-	if err != nil || result == nil || result.(int32) != 0 {
-		b.logger.Error("failed to execute command",
-			"type", component.CommandType,
-			"name", task.Component.Name,
-			"error", err)
+// Run the task's command component with the given arguments
+func (b *Basis) runCommand(
+	ctx context.Context, // context for the command
+	task *vagrant_server.Job_CommandOp, // task to run
+	args *vagrant_plugin_sdk.Command_Arguments, // arguments for the command
+) ([]string, error) {
+	return b.wrapRun(func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+		load, release := b.commandLoader()
+		defer release()
+
+		return b.runCommandComponent(ctx, task, args, load)
+	})(ctx, task)
+}
 
-		cmdErr := &runError{}
-		if err != nil {
-			cmdErr.err = err
-		}
-		if result != nil {
-			cmdErr.exitCode = result.(int32)
-		}
+// Load the command component with the given name
+func (b *Basis) commandComponent(ctx context.Context, name string) (*Component, error) {
+	return b.component(ctx, component.CommandType, name)
+}
 
-		return cmdErr
+// Returns a function which loads the command component for a
+// single run, and a function to release the loaded component
+// once the run is complete
+func (b *Basis) commandLoader() (load componentLoader, release func()) {
+	var cmd *Component
+	load = func(ctx context.Context, name string) (c *Component, err error) {
+		cmd, err = b.commandComponent(ctx, name)
+		return cmd, err
 	}
 
-	return
+	return load, func() { cmd.Release() }
+}
+
+// Run the task's command component, loaded using the provided
+// function, with the given arguments
+func (b *Basis) runCommandComponent(
+	ctx context.Context, // context for the command
+	task *vagrant_server.Job_CommandOp, // task to run
+	args *vagrant_plugin_sdk.Command_Arguments, // arguments for the command
+	load componentLoader, // loads the command component
+) ([]string, error) {
+	return b.runCommandIn(ctx, task, &commandRun{
+		scope:     b,
+		ui:        b.ui,
+		logger:    b.logger,
+		cmdLogger: b.commandLogger(),
+		jobInfo:   b.jobInfo,
+		typed:     []interface{}{args, b.jobInfo, b.dir, b.ctx, b.ui},
+		call:      b.callDynamicFunc,
+		load:      load,
+		rerun: func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+			return b.runCommand(ctx, task, task.CliArgs)
+		},
+	})
 }
 
 // Load a specific component
@@ -752,15 +1358,35 @@ func (b *Basis) component(
 	if typ == component.CommandType {
 		name = strings.Split(name, " ")[0]
 	}
-	c, err := b.plugins.Find(name, typ)
-	if err != nil {
+	if err := b.pluginPolicy.Allowed(typ, name); err != nil {
+		b.logger.Warn("plugin blocked by plugin policy",
+			"type", typ.String(),
+			"name", name,
+		)
+
 		return nil, err
 	}
 
+	// Start the plugin process if it was stopped, waiting
+	// for the plugin cap
+	if err := b.startPlugin(ctx, typ, name); err != nil {
+		return nil, err
+	}
+
+	// Hold the instance until the component is closed so
+	// the plugin process is not reaped while in use
+	c, held, err := b.plugins.Acquire(name, typ)
+	if err != nil {
+		if c, err = b.fallbackComponent(typ, name, err); err != nil {
+			return nil, b.pluginLoadCrash(err, typ, name)
+		}
+		held = func() {}
+	}
+
 	// TODO(spox): we need to add hooks
 
 	hooks := map[string][]*config.Hook{}
-	return &Component{
+	result := &Component{
 		Value: c.Component,
 		Info: &vagrant_server.Component{
 			Type:       vagrant_server.Component_Type(typ),
@@ -771,18 +1397,87 @@ func (b *Basis) component(
 		hooks:   hooks,
 		mappers: append(b.mappers, c.Mappers...),
 		plugin:  c,
-	}, nil
+		onClose: func() {
+			b.events.emit(EventPluginClosed, name, typ.String(), nil)
+		},
+		release: held,
+	}
+	if result, err = b.hookComponent(result); err != nil {
+		held()
+		return nil, err
+	}
+	if err = b.configureComponent(result, typ, name); err != nil {
+		held()
+		return nil, err
+	}
+	b.events.emit(EventComponentCreated, name, typ.String(), nil)
+
+	// If the component can report health, check it now so
+	// a component in a bad state is surfaced early
+	if _, ok := c.Component.(HealthChecker); ok {
+		health, err := b.componentHealth(ctx, result)
+		if err != nil || health != HealthHealthy {
+			b.logger.Warn("component reported unhealthy",
+				"type", typ.String(),
+				"name", name,
+				"health", health.String(),
+				"error", err,
+			)
+		}
+	}
+
+	return result, nil
 }
 
-// Load all components of a specific type
-func (b *Basis) typeComponents(
-	ctx context.Context, // context for the plugins,
-	typ component.Type, // type of the components,
-) (map[string]*Component, error) {
-	result := map[string]*Component{}
-	plugins, err := b.plugins.Typed(typ)
-	if err != nil {
-		return nil, err
+// Attempt to provide a missing component using the
+// configured fallback. If no fallback is configured, or
+// the fallback does not provide a plugin, the original
+// error is returned.
+func (b *Basis) fallbackComponent(
+	typ component.Type, // type of component
+	name string, // name of the component
+	findErr error, // error from the original lookup
+) (*plugin.Instance, error) {
+	if b.fallback == nil {
+		return nil, findErr
+	}
+
+	b.logger.Debug("component not found, requesting from fallback",
+		"type", typ.String(),
+		"name", name,
+	)
+
+	reg, err := b.fallback(typ, name)
+	if err != nil {
+		b.logger.Error("factory fallback failed to provide component",
+			"type", typ.String(),
+			"name", name,
+			"error", err,
+		)
+
+		return nil, err
+	}
+
+	if reg == nil {
+		return nil, findErr
+	}
+
+	if err = b.plugins.Register(reg); err != nil {
+		return nil, err
+	}
+
+	return b.plugins.Find(name, typ)
+}
+
+// Load all components of a specific type
+func (b *Basis) typeComponents(
+	ctx context.Context, // context for the plugins,
+	typ component.Type, // type of the components,
+) (map[string]*Component, error) {
+	result := map[string]*Component{}
+	plugins, err := b.plugins.Typed(typ)
+	if err != nil {
+		return nil, err
 	}
 
 	b.logger.Info("fetching all typed plugins",
@@ -794,6 +1489,9 @@ func (b *Basis) typeComponents(
 			"type", typ.String(),
 		)
 		c, err := b.component(ctx, typ, p)
+		if errors.Is(err, ErrPluginDenied) {
+			continue
+		}
 		if err != nil {
 			b.logger.Error("failed to fetch component",
 				"plugin", p,
@@ -819,6 +1517,9 @@ func (b *Basis) components(
 	for _, p := range b.plugins.Plugins {
 		for _, t := range p.Types {
 			c, err := b.component(ctx, t, p.Name)
+			if errors.Is(err, ErrPluginDenied) {
+				continue
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -835,7 +1536,10 @@ func (b *Basis) components(
 //
 // By default, the basis, provided context, and basis
 // UI are added as a typed arguments. The basis is
-// also added as a named argument.
+// also added as a named argument. Arguments provided
+// by the caller take precedence over the defaults, so
+// a caller can provide its own UI or logger for a
+// single call.
 func (b *Basis) callDynamicFunc(
 	ctx context.Context, // context for function execution
 	log hclog.Logger, // logger to provide function execution
@@ -844,8 +1548,9 @@ func (b *Basis) callDynamicFunc(
 	args ...argmapper.Arg, // list of argmapper arguments
 ) (interface{}, error) {
 	// ensure our UI status is closed after every call since this is
-	// the UI we send by default
-	defer b.ui.Status().Close()
+	// the UI we send by default. The status is only closed once all
+	// concurrent calls using the UI are complete.
+	defer b.uiStatus.acquire(b.ui)()
 
 	// Add seed arguments
 	for _, v := range b.seedValues.Typed {
@@ -853,8 +1558,6 @@ func (b *Basis) callDynamicFunc(
 			"fn", hclog.Fmt("%p", f),
 			"value", hclog.Fmt("%T", v),
 		)
-
-		args = append(args, argmapper.Typed(v))
 	}
 
 	for k, v := range b.seedValues.Named {
@@ -863,13 +1566,103 @@ func (b *Basis) callDynamicFunc(
 			"name", k,
 			"value", hclog.Fmt("%T", v),
 		)
-
-		args = append(args, argmapper.Named(k, v))
 	}
 
 	// Always include a logger within our arguments
-	args = append(args, argmapper.Typed(b.logger))
-	return dynamic.CallFunc(f, expectedType, b.mappers, args...)
+	typed := append(append([]interface{}{}, b.seedValues.Typed...), b.logger)
+
+	// Include the resolved environment so plugins have
+	// access to the effective configuration
+	if env := b.callEnvironment(ctx); env != nil {
+		typed = append(typed, env)
+	}
+
+	// Credentials are only provided when a provider is set. The
+	// secrets themselves are fetched on request by the plugin.
+	if creds := b.credentials(); creds != nil {
+		typed = append(typed, creds)
+	}
+	args = dynamicArgs(typed, b.seedValues.Named, args)
+	result, err := dynamic.CallFunc(f, expectedType, b.mappers, args...)
+	if err != nil {
+		var argErr *argmapper.ErrArgumentUnsatisfied
+		if errors.As(err, &argErr) {
+			b.logDynamicArgs(log, expectedType, argErr)
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Logs the arguments that were available when a dynamic
+// function call could not be satisfied so missing mappers
+// can be identified
+func (b *Basis) logDynamicArgs(
+	log hclog.Logger, // logger to write to
+	expectedType interface{}, // nil pointer of expected return type
+	argErr *argmapper.ErrArgumentUnsatisfied, // unsatisfied error from call
+) {
+	missing := make([]string, len(argErr.Args))
+	for i, v := range argErr.Args {
+		missing[i] = v.String()
+	}
+	inputs := make([]string, len(argErr.Inputs))
+	for i, v := range argErr.Inputs {
+		inputs[i] = v.String()
+	}
+
+	log.Debug("dynamic call failed, no conversion path for arguments",
+		"expected", hclog.Fmt("%T", expectedType),
+		"missing", missing,
+		"inputs", inputs,
+		"converters", len(argErr.Converters),
+	)
+
+	if !b.mapperDebug {
+		return
+	}
+
+	if argErr.Func != nil {
+		log.Info("mapper debug: unsatisfied function",
+			"func", argErr.Func.Name(),
+			"inputs", mapperValues(argErr.Func.Input()),
+		)
+	}
+	for _, v := range argErr.Inputs {
+		log.Info("mapper debug: available input",
+			"value", v.String(),
+		)
+	}
+	for _, c := range argErr.Converters {
+		log.Info("mapper debug: converter attempted",
+			"func", c.Name(),
+			"inputs", mapperValues(c.Input()),
+			"outputs", mapperValues(c.Output()),
+		)
+	}
+	for _, v := range argErr.Args {
+		log.Info("mapper debug: missing argument",
+			"value", v.String(),
+			"expected", hclog.Fmt("%T", expectedType),
+		)
+	}
+}
+
+// Provide string representations of all values within the set
+func mapperValues(vs *argmapper.ValueSet) []string {
+	if vs == nil {
+		return nil
+	}
+
+	values := vs.Values()
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.String()
+	}
+
+	return result
 }
 
 func (b *Basis) seed(fn func(*core.Seeds)) {
@@ -1004,7 +1797,7 @@ func (b *Basis) execHook(
 	log hclog.Logger,
 	h *config.Hook,
 ) error {
-	return execHook(ctx, b, log, h)
+	return execHook(ctx, b, b, log, h)
 }
 
 func (b *Basis) doOperation(
@@ -1012,20 +1805,129 @@ func (b *Basis) doOperation(
 	log hclog.Logger,
 	op operation,
 ) (interface{}, proto.Message, error) {
-	return doOperation(ctx, log, b, op)
+	return b.wrapOperation(b, op)(ctx, log)
+}
+
+// Wrap the operation for the given scope with the basis
+// operation middleware
+func (b *Basis) wrapOperation(s scope, op operation) OperationFunc {
+	run := wrapOperation(
+		func(ctx context.Context, log hclog.Logger) (interface{}, proto.Message, error) {
+			return doOperation(ctx, log, s, op)
+		},
+		b.middleware,
+	)
+
+	// Track the operation so it can be cancelled
+	return func(ctx context.Context, log hclog.Logger) (interface{}, proto.Message, error) {
+		if err := b.hooks.beforeOperation(ctx, b); err != nil {
+			return nil, nil, err
+		}
+
+		// Check required capabilities before anything is run
+		if skip, err := b.preflightOperation(s, op); skip || err != nil {
+			return nil, nil, err
+		}
+
+		// Return the recorded result if the operation was already run
+		result, msg, replayed, err := b.idempotent.run(ctx, idempotencyKey(s, op),
+			func() (interface{}, proto.Message, error) {
+				ctx, done := b.operations.track(ctx, s.JobInfo(), operationName(op))
+				defer done()
+
+				return run(ctx, log)
+			},
+		)
+		if replayed {
+			log.Info("operation already run, returning recorded result",
+				"operation", operationName(op),
+			)
+		}
+
+		return result, msg, err
+	}
+}
+
+func (b *Basis) retryPolicy(op operation) *operationRetry {
+	if r, ok := b.retryTypes[operationName(op)]; ok {
+		return r
+	}
+
+	return b.retry
 }
 
+// Name of the configuration file loaded from the basis path
+const basisConfigFilename = "vagrant-config.hcl"
+
 // BasisOption is used to set options for NewBasis.
 type BasisOption func(*Basis) error
 
+// FactoryFallback is called when a requested component is not
+// provided by any registered plugin. It may return a registration
+// for a plugin providing the component, or nil if it cannot.
+type FactoryFallback func(typ component.Type, name string) (plugin.PluginRegistration, error)
+
+// ConfigLoader provides configuration from a custom source
+type ConfigLoader func() (*config.Config, error)
+
+// ProjectConstructor creates the initial project instance for
+// a basis. Values set by project options take precedence over
+// the values of the returned project.
+type ProjectConstructor func(*Basis) *Project
+
+// ConfigValidator enforces custom rules on the effective configuration
+type ConfigValidator func(*config.Config) error
+
 // WithClient sets the API client to use.
 func WithClient(client *serverclient.VagrantClient) BasisOption {
 	return func(b *Basis) (err error) {
+		b.optionApplied("WithClient")
 		b.client = client
 		return
 	}
 }
 
+// WithCancelCleanupTimeout sets the time allowed for each cleanup
+// callback registered by a command to run after the command is
+// cancelled.
+func WithCancelCleanupTimeout(d time.Duration) BasisOption {
+	return func(b *Basis) (err error) {
+		if d <= 0 {
+			return fmt.Errorf("cleanup timeout must be greater than zero")
+		}
+		b.cancelTimeout = d
+		return
+	}
+}
+
+// WithShutdownTimeout sets the maximum time allowed to close the
+// basis. Closers which have not completed when the timeout is
+// reached are abandoned and reported in the returned error.
+func WithShutdownTimeout(d time.Duration) BasisOption {
+	return func(b *Basis) (err error) {
+		if d <= 0 {
+			return fmt.Errorf("shutdown timeout must be greater than zero")
+		}
+		b.closeTimeout = d
+		return
+	}
+}
+
+// WithBasisTimeout sets the time allowed to construct the basis.
+// Server requests made while loading the basis and by Init fail
+// once the time is reached so a slow or unreachable server does
+// not block indefinitely. The time starts once all options have
+// been applied, so the order of options does not matter.
+func WithBasisTimeout(d time.Duration) BasisOption {
+	return func(b *Basis) (err error) {
+		if d <= 0 {
+			return fmt.Errorf("basis timeout must be greater than zero")
+		}
+		b.setupTimeout = d
+		return
+	}
+}
+
 // WithLogger sets the logger to use with the project. If this option
 // is not provided, a default logger will be used (`hclog.L()`).
 func WithLogger(log hclog.Logger) BasisOption {
@@ -1035,6 +1937,25 @@ func WithLogger(log hclog.Logger) BasisOption {
 	}
 }
 
+// WithLoggerFields attaches fields to the basis logger. The fields
+// are also attached to the loggers of projects, targets, and plugins
+// within the basis. Arguments are key/value pairs as accepted by
+// hclog.Logger.With.
+func WithLoggerFields(args ...interface{}) BasisOption {
+	return func(b *Basis) (err error) {
+		if len(args)%2 != 0 {
+			return fmt.Errorf("logger fields must be key/value pairs")
+		}
+		for i := 0; i < len(args); i += 2 {
+			if _, ok := args[i].(string); !ok {
+				return fmt.Errorf("logger field key must be a string, got %T", args[i])
+			}
+		}
+		b.logFields = append(b.logFields, args...)
+		return
+	}
+}
+
 func WithPluginManager(m *plugin.Manager) BasisOption {
 	return func(b *Basis) (err error) {
 		b.plugins = m
@@ -1042,10 +1963,36 @@ func WithPluginManager(m *plugin.Manager) BasisOption {
 	}
 }
 
-// WithMappers adds the mappers to the list of mappers.
+// WithMappers adds the mappers to the list of mappers. These are
+// appended after the default mapper set.
 func WithMappers(m ...*argmapper.Func) BasisOption {
 	return func(b *Basis) (err error) {
-		b.mappers = append(b.mappers, m...)
+		b.optionMappers = append(b.optionMappers, m...)
+		return
+	}
+}
+
+// WithMapperSet replaces the default mapper set (protomappers.All)
+// with the provided mappers. The local command mappers and any
+// mappers added with WithMappers are still appended after this set.
+//
+// When multiple mappers can satisfy the same conversion, the
+// resolution is not guaranteed to prefer one over the other. To
+// shadow a default mapper, omit it from the provided set rather
+// than adding a competing mapper with WithMappers.
+func WithMapperSet(m ...*argmapper.Func) BasisOption {
+	return func(b *Basis) (err error) {
+		b.mapperSet = make([]*argmapper.Func, len(m))
+		copy(b.mapperSet, m)
+		return
+	}
+}
+
+// WithStdin attaches input which is provided to commands. Commands
+// receive empty input when this is not set.
+func WithStdin(r io.Reader) BasisOption {
+	return func(b *Basis) (err error) {
+		b.stdin = r
 		return
 	}
 }
@@ -1053,7 +2000,409 @@ func WithMappers(m ...*argmapper.Func) BasisOption {
 // WithUI sets the UI to use. If this isn't set, a BasicUI is used.
 func WithUI(ui terminal.UI) BasisOption {
 	return func(b *Basis) (err error) {
-		b.ui = ui
+		b.uiBase = ui
+		b.uiConsole = false
+		return
+	}
+}
+
+// WithUIColor sets if color is used for output by the console UI
+// created by the basis. When color is disabled, color is removed
+// from the output. When color is always used, or FORCE_COLOR is
+// set in auto mode, color is enabled even if output is not a
+// terminal. The setting is also provided to plugins.
+func WithUIColor(mode ColorMode) BasisOption {
+	return func(b *Basis) (err error) {
+		if mode > ColorNever {
+			return fmt.Errorf("invalid color mode %d", mode)
+		}
+		b.colorMode = mode
+		return
+	}
+}
+
+// WithMachineReadableUI formats all output written to the basis
+// UI, including output from plugins, using the machine readable
+// format.
+func WithMachineReadableUI() BasisOption {
+	return func(b *Basis) (err error) {
+		b.optionApplied("WithMachineReadableUI")
+		b.machineUI = true
+		return
+	}
+}
+
+// WithUIRenderer sets a renderer which formats all messages, steps,
+// and status updates written to the basis UI before they are
+// displayed. This allows output to be formatted without replacing
+// the UI.
+func WithUIRenderer(r Renderer) BasisOption {
+	return func(b *Basis) (err error) {
+		if r == nil {
+			return fmt.Errorf("renderer cannot be nil")
+		}
+		b.optionApplied("WithUIRenderer")
+		b.renderer = r
+		return
+	}
+}
+
+// WithOutputFilter sets a filter which every line of output
+// passes through before it is displayed. Lines written by plugins
+// in multiple writes are filtered once the line is complete.
+func WithOutputFilter(filter func(string) string) BasisOption {
+	return func(b *Basis) (err error) {
+		b.outputFilter = filter
+		return
+	}
+}
+
+// WithOperationOutputLimit sets the number of bytes of output
+// retained for LastOperationOutput. Output beyond the limit is
+// dropped, oldest first.
+func WithOperationOutputLimit(size int) BasisOption {
+	return func(b *Basis) (err error) {
+		if size < 1 {
+			return fmt.Errorf("operation output limit must be greater than zero")
+		}
+		b.outputLimit = size
+		return
+	}
+}
+
+// WithMapperDebug logs the full set of available inputs and
+// converters when a dynamic function call cannot be satisfied
+func WithMapperDebug() BasisOption {
+	return func(b *Basis) (err error) {
+		b.mapperDebug = true
+		return
+	}
+}
+
+// WithOperationRetry sets the number of times an operation will be
+// retried when it fails with a retryable error (see Retryable). The
+// backoff is the delay before the first retry and is doubled for each
+// subsequent retry, up to a maximum, with jitter applied. Errors which
+// are not retryable fail immediately. Options can restrict the policy
+// to a specific operation type.
+func WithOperationRetry(n int, backoff time.Duration, opts ...RetryOption) BasisOption {
+	return func(b *Basis) (err error) {
+		r, err := newOperationRetry(n, backoff, opts...)
+		if err != nil {
+			return
+		}
+		if r.operation == "" {
+			b.retry = r
+		} else {
+			b.retryTypes[r.operation] = r
+		}
+		return
+	}
+}
+
+// WithDefaultProvider sets the provider used when a provider is
+// not specified. This takes precedence over VAGRANT_DEFAULT_PROVIDER
+// and the configuration. See DefaultProvider for the full order.
+func WithDefaultProvider(name string) BasisOption {
+	return func(b *Basis) (err error) {
+		if name == "" {
+			return fmt.Errorf("default provider name cannot be empty")
+		}
+		b.defaultProv = name
+		return
+	}
+}
+
+// WithRequiredCapability requires the named capability to be
+// supported before operations of the given type, or commands with
+// the given name, are run. The capability is checked on the detected
+// host for HostType or on the target's provider for ProviderType.
+// Provider capabilities are not checked for commands run on a
+// project or basis. When it is not
+// supported the operation is aborted with ErrCapabilityUnsupported,
+// or skipped if set by WithCapabilityMissingMode.
+func WithRequiredCapability(
+	operation string, // operation type requiring the capability
+	typ component.Type, // type of component providing the capability
+	capability string, // name of the capability
+) BasisOption {
+	return func(b *Basis) (err error) {
+		if typ != component.HostType && typ != component.ProviderType {
+			return fmt.Errorf("required capability %s must be provided by a host or provider", capability)
+		}
+		b.capRequired = append(b.capRequired, CapabilityRequirement{
+			Operation:  operation,
+			Type:       typ,
+			Capability: capability,
+		})
+		return
+	}
+}
+
+// WithCapabilityMissingMode sets the behavior when an operation
+// requires a capability which is not supported. This takes
+// precedence over the capabilities configuration.
+func WithCapabilityMissingMode(mode CapabilityMissingMode) BasisOption {
+	return func(b *Basis) (err error) {
+		b.capMissing = &mode
+		return
+	}
+}
+
+// WithMaxConcurrentPlugins limits the number of plugin processes
+// which can be running at the same time. When the limit is reached,
+// the least recently used idle plugin process is stopped to start
+// another. If no process is idle, starting a plugin is queued until
+// a process is stopped. Queue wait times are available from
+// PluginQueueStats. If WithMaxPlugins is also set, the lower limit
+// applies.
+func WithMaxConcurrentPlugins(n int) BasisOption {
+	return func(b *Basis) (err error) {
+		if n < 1 {
+			return fmt.Errorf("plugin concurrency limit must be at least 1")
+		}
+		b.pluginLimit = n
+		return
+	}
+}
+
+// WithMaxPlugins limits the number of plugin processes which can
+// be running at the same time. When the limit is reached, the least
+// recently used idle plugin process is stopped to start another. If
+// no process is idle, starting a plugin blocks or returns an error
+// based on the mode set with WithPluginCapMode. The limit applies to
+// all processes of the plugin manager provided to the basis.
+func WithMaxPlugins(n int) BasisOption {
+	return func(b *Basis) (err error) {
+		if n < 1 {
+			return fmt.Errorf("plugin limit must be at least 1")
+		}
+		b.pluginCap.max = n
+		return
+	}
+}
+
+// WithOperationMiddleware adds middleware which wraps the execution
+// of all operations. Middleware is run in the order registered with
+// the first registered being the outermost.
+func WithOperationMiddleware(mw ...OperationMiddleware) BasisOption {
+	return func(b *Basis) (err error) {
+		b.middleware = append(b.middleware, mw...)
+		return
+	}
+}
+
+// WithCommandMiddleware adds middleware which wraps the running of
+// all commands, including commands run by other commands. Middleware
+// is run in the order registered with the first registered being
+// the outermost.
+func WithCommandMiddleware(mw ...CommandMiddleware) BasisOption {
+	return func(b *Basis) (err error) {
+		b.cmdMiddleware = append(b.cmdMiddleware, mw...)
+		return
+	}
+}
+
+// WithProgressReporter sets the reporter which receives structured
+// progress events from long running operations. Events are reported
+// for command runs, boxes added to the box collection, downloads,
+// provisioning, and updates made through Progress by plugins.
+func WithProgressReporter(r ProgressReporter) BasisOption {
+	return func(b *Basis) (err error) {
+		if r == nil {
+			return fmt.Errorf("progress reporter cannot be nil")
+		}
+		b.progress = r
+		return
+	}
+}
+
+// WithGlobalConfig sets the machine wide configuration. Values from
+// this configuration have the lowest precedence.
+func WithGlobalConfig(c *config.Config) BasisOption {
+	return func(b *Basis) (err error) {
+		b.globalConfig = c
+		return
+	}
+}
+
+// WithConfig sets the configuration for the basis path. Values from
+// this configuration take precedence over the global configuration
+// but can be overridden by the environment.
+func WithConfig(c *config.Config) BasisOption {
+	return func(b *Basis) (err error) {
+		b.optionApplied("WithConfig")
+		b.pathConfig = c
+		return
+	}
+}
+
+// WithConfigWatch reloads the configuration when the configuration
+// file for the basis path is modified. If the modified configuration
+// is not valid, the current configuration is kept. Policies derived
+// from the configuration when the basis was created are not changed.
+func WithConfigWatch() BasisOption {
+	return func(b *Basis) (err error) {
+		b.configWatch = true
+		return
+	}
+}
+
+// WithPluginPolicy sets the policy restricting which plugins may be
+// used. This takes precedence over any plugin restrictions defined
+// within the configuration.
+func WithPluginPolicy(p *PluginPolicy) BasisOption {
+	return func(b *Basis) (err error) {
+		if p == nil {
+			return
+		}
+		if err = p.Validate(); err != nil {
+			return
+		}
+		b.pluginPolicy = p
+		return
+	}
+}
+
+// WithPluginCapMode sets the behavior when the limit set with
+// WithMaxPlugins is reached.
+func WithPluginCapMode(mode PluginCapMode) BasisOption {
+	return func(b *Basis) (err error) {
+		if mode != PluginCapBlock && mode != PluginCapError {
+			return fmt.Errorf("invalid plugin cap mode: %d", mode)
+		}
+		b.pluginCap.mode = mode
+		return
+	}
+}
+
+// WithPluginIdleTTL stops plugin processes which have not been used
+// within the given duration. Stopped plugins are started again when
+// next used.
+func WithPluginIdleTTL(ttl time.Duration) BasisOption {
+	return func(b *Basis) (err error) {
+		if ttl <= 0 {
+			return fmt.Errorf("plugin idle TTL must be greater than zero")
+		}
+		b.pluginCap.ttl = ttl
+		return
+	}
+}
+
+// WithPluginEnv sets environment variables provided to plugin
+// processes. By default the variables are merged over the host
+// environment inherited by plugins. See plugin.Env for details
+// on precedence.
+func WithPluginEnv(env map[string]string) BasisOption {
+	return func(b *Basis) (err error) {
+		e := b.ensurePluginEnv()
+		for k, v := range env {
+			if k == "" || strings.Contains(k, "=") {
+				return fmt.Errorf("invalid plugin environment variable name %q", k)
+			}
+			e.Vars[k] = v
+		}
+		return
+	}
+}
+
+// WithPluginEnvMode sets if plugin processes inherit the host
+// environment. When set to plugin.EnvReplace only the variables
+// from WithPluginEnv and WithPluginEnvPassthrough are provided.
+func WithPluginEnvMode(mode plugin.EnvMode) BasisOption {
+	return func(b *Basis) (err error) {
+		if mode != plugin.EnvMerge && mode != plugin.EnvReplace {
+			return fmt.Errorf("invalid plugin environment mode %d", mode)
+		}
+		b.ensurePluginEnv().Mode = mode
+		return
+	}
+}
+
+// WithPluginEnvPassthrough restricts the host environment variables
+// inherited by plugin processes to the given names
+func WithPluginEnvPassthrough(names ...string) BasisOption {
+	return func(b *Basis) (err error) {
+		e := b.ensurePluginEnv()
+		if e.Passthrough == nil {
+			e.Passthrough = []string{}
+		}
+		e.Passthrough = append(e.Passthrough, names...)
+		return
+	}
+}
+
+// WithBasisConfigLoader sets a custom loader used to provide the
+// path configuration. When set, the loader is used instead of any
+// configuration provided by WithConfig.
+func WithBasisConfigLoader(l ConfigLoader) BasisOption {
+	return func(b *Basis) (err error) {
+		b.configLoader = l
+		return
+	}
+}
+
+// WithConfigValidator adds a validator which is run against the
+// effective configuration when the basis is created. Validators are
+// run in the order registered and all failures are reported.
+func WithConfigValidator(v ConfigValidator) BasisOption {
+	return func(b *Basis) (err error) {
+		if v == nil {
+			return fmt.Errorf("config validator cannot be nil")
+		}
+		b.validators = append(b.validators, v)
+		return
+	}
+}
+
+// WithTraceContext continues the trace of the caller. The values
+// of the given context, like the caller's trace span, are provided
+// by the basis context, which is the context given to plugin
+// functions. The cancellation and deadline of the given context
+// are not used.
+func WithTraceContext(ctx context.Context) BasisOption {
+	return func(b *Basis) (err error) {
+		if ctx == nil {
+			return fmt.Errorf("trace context cannot be nil")
+		}
+		b.traceCtx = ctx
+		return
+	}
+}
+
+// WithProjectConstructor sets the function used to create the
+// initial project instance when projects are loaded. This allows
+// custom project values, like a UI, to be provided.
+func WithProjectConstructor(fn ProjectConstructor) BasisOption {
+	return func(b *Basis) (err error) {
+		if fn == nil {
+			return fmt.Errorf("project constructor cannot be nil")
+		}
+		b.projectCtor = fn
+		return
+	}
+}
+
+// WithMaxLoadedProjects sets the maximum number of projects which
+// are kept loaded by the basis. When exceeded, the least recently
+// used project is closed. Closed projects are reloaded when they are
+// requested again. Projects with in-flight operations are not closed
+// until the operations complete.
+func WithMaxLoadedProjects(n int) BasisOption {
+	return func(b *Basis) (err error) {
+		if n < 1 {
+			return fmt.Errorf("maximum loaded projects must be greater than zero")
+		}
+		b.projects.max = n
+		return
+	}
+}
+
+// WithProjectEvicted sets a function which is called after a project
+// has been closed due to the limit set with WithMaxLoadedProjects.
+func WithProjectEvicted(fn func(*Project)) BasisOption {
+	return func(b *Basis) (err error) {
+		b.projects.onEvict = fn
 		return
 	}
 }
@@ -1069,14 +2418,86 @@ func WithJobInfo(info *component.JobInfo) BasisOption {
 // WithBasisDataDir customizes the datadir for the Basis
 func WithBasisDataDir(dir *datadir.Basis) BasisOption {
 	return func(b *Basis) (err error) {
+		b.optionApplied("WithBasisDataDir")
 		b.dir = dir
 		return
 	}
 }
 
+// WithBasisLabels sets labels on the basis. The labels are
+// applied to the stored basis when it is initialized.
+func WithBasisLabels(labels map[string]string) BasisOption {
+	return func(b *Basis) (err error) {
+		if b.labels == nil {
+			b.labels = map[string]string{}
+		}
+		for k, v := range labels {
+			if err = ValidateLabel(k, v); err != nil {
+				return
+			}
+			b.labels[k] = v
+		}
+
+		return
+	}
+}
+
+// WithBasisPath sets the basis path to the given root directory
+// and derives both the data directory and the path configuration
+// from it so they are always consistent. The configuration is
+// loaded from the vagrant-config.hcl file within the root if it
+// exists. The data directory is created and the configuration is
+// loaded once all options have been applied.
+func WithBasisPath(root string) BasisOption {
+	return func(b *Basis) (err error) {
+		b.optionApplied("WithBasisPath")
+		if root, err = filepath.Abs(root); err != nil {
+			return
+		}
+		info, err := os.Stat(root)
+		if err != nil {
+			return fmt.Errorf("invalid basis path: %w", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("invalid basis path, %s is not a directory", root)
+		}
+
+		b.basis.Path = root
+		if b.basis.Name == "" {
+			b.basis.Name = filepath.Base(root)
+		}
+		b.rootPath = root
+
+		return
+	}
+}
+
+// Setup the data directory and path configuration from the
+// basis path
+func (b *Basis) loadBasisPath() (err error) {
+	if b.dir, err = datadir.NewBasis(b.dataDirIdent()); err != nil {
+		return
+	}
+
+	cpath := filepath.Join(b.rootPath, basisConfigFilename)
+	b.configPath = cpath
+	if _, err = os.Stat(cpath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return
+	}
+	if b.pathConfig, err = config.Load(cpath, b.rootPath); err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	return
+}
+
 // WithBasisRef is used to load or initialize the basis
 func WithBasisRef(r *vagrant_plugin_sdk.Ref_Basis) BasisOption {
 	return func(b *Basis) (err error) {
+		b.optionApplied("WithBasisRef")
 		if r.ResourceId != "" {
 			b.basis.ResourceId = r.ResourceId
 		}
@@ -1091,23 +2512,52 @@ func WithBasisRef(r *vagrant_plugin_sdk.Ref_Basis) BasisOption {
 	}
 }
 
+// WithBasisResourceId loads the existing basis with the given
+// resource id. The basis is loaded once all options have been
+// applied.
 func WithBasisResourceId(rid string) BasisOption {
 	return func(b *Basis) (err error) {
-		result, err := b.client.FindBasis(b.ctx, &vagrant_server.FindBasisRequest{
-			Basis: &vagrant_server.Basis{
-				ResourceId: rid,
-			},
-		})
-		if err != nil {
-			return
-		}
-		if result == nil {
-			b.logger.Error("failed to locate basis during setup",
-				"resource-id", rid)
+		b.optionApplied("WithBasisResourceId")
+		b.resourceId = rid
+		return
+	}
+}
 
-			return fmt.Errorf("requested basis is not found (resource-id: %s", rid)
-		}
-		b.basis = result.Basis
+// Load the basis requested by resource id
+func (b *Basis) loadResourceId() error {
+	if b.client == nil {
+		return fmt.Errorf("client required to load basis (resource-id: %s)", b.resourceId)
+	}
+
+	ctx, cancel := b.requestCtx()
+	defer cancel()
+
+	result, err := b.client.FindBasis(ctx, &vagrant_server.FindBasisRequest{
+		Basis: &vagrant_server.Basis{
+			ResourceId: b.resourceId,
+		},
+	})
+	if err != nil {
+		return newServerError(err, "find basis", b.resourceId)
+	}
+	if result == nil {
+		b.logger.Error("failed to locate basis during setup",
+			"resource-id", b.resourceId)
+
+		return fmt.Errorf("requested basis is not found (resource-id: %s", b.resourceId)
+	}
+	b.basis = result.Basis
+
+	return nil
+}
+
+// WithEphemeral marks the basis as ephemeral. An ephemeral basis
+// is not saved to the server when it is initialized or closed,
+// which is useful for temporary or read only operations. An
+// existing basis which is loaded is not modified.
+func WithEphemeral() BasisOption {
+	return func(b *Basis) (err error) {
+		b.ephemeral = true
 		return
 	}
 }
@@ -1119,13 +2569,85 @@ func WithFactory(f *Factory) BasisOption {
 	}
 }
 
+// WithFactoryFallback sets a fallback used to provide plugins for
+// components which are not known to the plugin manager. The plugin
+// returned by the fallback is registered with the basis plugin
+// manager so it will be available for subsequent requests.
+func WithFactoryFallback(fn FactoryFallback) BasisOption {
+	return func(b *Basis) (err error) {
+		b.fallback = fn
+		return
+	}
+}
+
+// WithComponentHook sets a hook which is called as each component
+// is created and before it is closed. The hook may wrap the value
+// of the component.
+func WithComponentHook(hook ComponentHook) BasisOption {
+	return func(b *Basis) (err error) {
+		b.componentHook = hook
+		return
+	}
+}
+
+// WithIdempotencyTTL enables idempotent operations. Operations run
+// as part of a job are recorded by the job id, scope, and operation
+// name. If the same operation is dispatched again before the TTL
+// expires, the recorded result is returned instead of running the
+// operation again. Use ForceOperation to run the operation anyway.
+func WithIdempotencyTTL(d time.Duration) BasisOption {
+	return func(b *Basis) (err error) {
+		if d <= 0 {
+			return fmt.Errorf("idempotency TTL must be greater than zero")
+		}
+		b.idempotent = newIdempotencyCache(d)
+		return
+	}
+}
+
+// WithCredentialProvider sets the provider of credentials for
+// plugins. Plugin functions receive a Credentials value which
+// requests secrets from the provider.
+func WithCredentialProvider(p CredentialProvider) BasisOption {
+	return func(b *Basis) (err error) {
+		b.credProvider = p
+		return
+	}
+}
+
+// WithCredentialStore sets the store credentials for plugins are
+// fetched from. Plugin functions receive a Credentials value which
+// requests secrets from the store. This replaces any credential
+// provider set on the basis.
+func WithCredentialStore(s CredentialStore) BasisOption {
+	return func(b *Basis) (err error) {
+		if s == nil {
+			return fmt.Errorf("credential store must not be nil")
+		}
+		b.credProvider = &storeCredentialProvider{store: s}
+		return
+	}
+}
+
+// WithBasisHooks sets callbacks invoked at points in the basis
+// lifecycle. A panic within a hook is returned as an error.
+func WithBasisHooks(hooks BasisHooks) BasisOption {
+	return func(b *Basis) (err error) {
+		b.optionApplied("WithBasisHooks")
+		b.hooks = &basisHooks{BasisHooks: hooks}
+		return
+	}
+}
+
 func FromBasis(basis *Basis) BasisOption {
 	return func(b *Basis) (err error) {
+		b.optionApplied("FromBasis")
 		b.logger = basis.logger
 		b.plugins = basis.plugins // TODO(spox): we need stacked managers
 		b.ctx = basis.ctx
 		b.client = basis.client
-		b.ui = basis.ui
+		b.uiBase = basis.uiBase
+		b.uiConsole = basis.uiConsole
 		return
 	}
 }
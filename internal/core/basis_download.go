@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// Download runs the named downloader plugin which writes to the
+// destination. Progress events are reported for the download and
+// the completed event includes the size of the destination.
+// Downloaders may report their own progress by requesting Progress.
+func (b *Basis) Download(
+	ctx context.Context, // context for the download
+	name string, // name of the downloader plugin
+	dest string, // path the downloader writes to
+	args ...argmapper.Arg, // arguments for the downloader
+) error {
+	c, err := b.component(ctx, component.DownloaderType, name)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	d, ok := c.Value.(component.Downloader)
+	if !ok {
+		return fmt.Errorf("plugin %s is not a downloader", name)
+	}
+
+	args = append(args, argmapper.ConverterFunc(c.mappers...))
+	tracker, err := b.callWithProgress(ctx, b.callDynamicFunc, b.ui,
+		fmt.Sprintf("download %s", dest), ProgressPhaseDownloading,
+		d.DownloadFunc(), args...)
+	if err != nil {
+		return err
+	}
+
+	var size int64
+	if info, err := os.Stat(dest); err == nil {
+		size = info.Size()
+	}
+	tracker.bytes(ProgressPhaseCompleted, 100, size, size)
+
+	return nil
+}
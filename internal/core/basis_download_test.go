@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+// testDownloader writes data to the destination and reports progress
+type testDownloader struct {
+	plugin.TestPluginWithFakeBroker
+
+	data string
+	dest string
+	err  error
+}
+
+func (d *testDownloader) DownloadFunc() interface{} {
+	return func(p *Progress) error {
+		p.Update("fetching", 50)
+		if d.err != nil {
+			return d.err
+		}
+		return os.WriteFile(d.dest, []byte(d.data), 0644)
+	}
+}
+
+// Records reported progress events
+type testProgressEvents struct {
+	events []*ProgressEvent
+
+	m sync.Mutex
+}
+
+func (r *testProgressEvents) Report(e *ProgressEvent) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.events = append(r.events, e)
+}
+
+// Phases of the reported events. Every event must be
+// reported for the same operation.
+func (r *testProgressEvents) phases(t *testing.T) []string {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	phases := []string{}
+	for _, e := range r.events {
+		require.NotEmpty(t, e.OperationID)
+		require.Equal(t, r.events[0].OperationID, e.OperationID)
+		phases = append(phases, e.Phase)
+	}
+
+	return phases
+}
+
+func TestBasisDownload(t *testing.T) {
+	dest := filepath.Join(t.TempDir(), "box")
+	d := &testDownloader{data: "box data", dest: dest}
+	p := plugin.TestPlugin(t, d,
+		plugin.WithPluginName("http"),
+		plugin.WithPluginTypes(component.DownloaderType),
+	)
+	reporter := &testProgressEvents{}
+	b := TestBasis(t,
+		WithPluginManager(plugin.TestManager(t, p)),
+		WithProgressReporter(reporter),
+	)
+
+	require.NoError(t, b.Download(context.Background(), "http", dest))
+	require.Equal(t, []string{
+		ProgressPhaseStarted,
+		ProgressPhaseDownloading,
+		"fetching",
+		ProgressPhaseCompleted,
+	}, reporter.phases(t))
+
+	last := reporter.events[len(reporter.events)-1]
+	require.Equal(t, "download "+dest, last.Operation)
+	require.Equal(t, int64(len(d.data)), last.Bytes)
+	require.Equal(t, int64(len(d.data)), last.TotalBytes)
+
+	t.Run("failed download", func(t *testing.T) {
+		reporter.events = nil
+		d.err = errors.New("connection refused")
+
+		err := b.Download(context.Background(), "http", dest)
+		require.ErrorIs(t, err, d.err)
+		require.Equal(t, []string{
+			ProgressPhaseStarted,
+			ProgressPhaseDownloading,
+			"fetching",
+			ProgressPhaseFailed,
+		}, reporter.phases(t))
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BasisHooks are callbacks invoked at points in the basis
+// lifecycle. They are intended for programs embedding the basis
+// and are separate from hooks defined in configuration. Any hook
+// may be nil.
+type BasisHooks struct {
+	// AfterLoad is called once the basis is initialized. An error
+	// fails the initialization.
+	AfterLoad func(*Basis) error
+
+	// BeforeOperation is called before the first operation or
+	// command is run. An error aborts the operation and the hook
+	// is called again before the next operation.
+	BeforeOperation func(context.Context, *Basis) error
+
+	// BeforeClose is called before the basis is closed. The basis
+	// is closed even if an error is returned, and the error is
+	// returned from Close.
+	BeforeClose func(*Basis) error
+}
+
+// Tracks invocation of the basis hooks
+type basisHooks struct {
+	BasisHooks
+
+	m       sync.Mutex
+	started bool // before operation hook completed
+}
+
+// Call the after load hook
+func (h *basisHooks) afterLoad(b *Basis) error {
+	if h == nil || h.AfterLoad == nil {
+		return nil
+	}
+
+	return callBasisHook("after load", func() error {
+		return h.AfterLoad(b)
+	})
+}
+
+// Call the before operation hook if it has not yet
+// completed successfully
+func (h *basisHooks) beforeOperation(ctx context.Context, b *Basis) error {
+	if h == nil || h.BeforeOperation == nil {
+		return nil
+	}
+
+	h.m.Lock()
+	defer h.m.Unlock()
+	if h.started {
+		return nil
+	}
+
+	if err := callBasisHook("before operation", func() error {
+		return h.BeforeOperation(ctx, b)
+	}); err != nil {
+		return err
+	}
+	h.started = true
+
+	return nil
+}
+
+// Call the before close hook
+func (h *basisHooks) beforeClose(b *Basis) error {
+	if h == nil || h.BeforeClose == nil {
+		return nil
+	}
+
+	return callBasisHook("before close", func() error {
+		return h.BeforeClose(b)
+	})
+}
+
+// Call the hook, converting a panic within the hook into
+// an error
+func callBasisHook(phase string, fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s hook panicked: %v", phase, r)
+		}
+	}()
+
+	if err = fn(); err != nil {
+		err = fmt.Errorf("%s hook failed: %w", phase, err)
+	}
+
+	return
+}
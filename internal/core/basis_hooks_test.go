@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestBasisHooks(t *testing.T) {
+	var loaded, started int
+	fail := errors.New("not yet")
+	closeErr := errors.New("still busy")
+	hooks := BasisHooks{
+		AfterLoad: func(*Basis) error {
+			loaded++
+			return nil
+		},
+		BeforeOperation: func(context.Context, *Basis) error {
+			started++
+			if started == 1 {
+				return fail
+			}
+			return nil
+		},
+		BeforeClose: func(*Basis) error {
+			if closeErr != nil {
+				return closeErr
+			}
+			panic("closing")
+		},
+	}
+
+	b := TestBasis(t, WithBasisHooks(hooks))
+	require.Equal(t, 1, loaded)
+
+	// A failing hook aborts the command and is retried
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "unknown",
+		Component: &vagrant_server.Component{Name: "unknown"},
+	}
+	_, err := b.Run(context.Background(), task)
+	require.ErrorIs(t, err, fail)
+	_, err = b.Run(context.Background(), task)
+	require.NotErrorIs(t, err, fail)
+	b.Run(context.Background(), task)
+	require.Equal(t, 2, started)
+
+	// Hook errors are returned but the basis is still closed
+	closed := false
+	b.Closer(func() error {
+		closed = true
+		return nil
+	})
+	require.ErrorIs(t, b.Close(), closeErr)
+	require.True(t, closed)
+
+	// Panics are errors
+	closeErr = nil
+	require.ErrorContains(t, b.Close(), "before close hook panicked: closing")
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+	"github.com/hashicorp/vagrant/internal/serverclient"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Prefix of basis metadata keys which store labels
+const basisLabelPrefix = "label."
+
+var (
+	// Allowed format of label keys
+	labelKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,62}$`)
+	// Allowed format of label values
+	labelValueRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]{0,63}$`)
+)
+
+// ValidateLabel checks that the label key and value only
+// contain allowed characters. Keys must start with a letter
+// or number and may contain letters, numbers, '.', '_' and
+// '-'. Values may contain the same characters and can be empty.
+func ValidateLabel(key, value string) error {
+	if !labelKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid label key %q", key)
+	}
+	if !labelValueRegexp.MatchString(value) {
+		return fmt.Errorf("invalid value %q for label %q", value, key)
+	}
+
+	return nil
+}
+
+// ParseLabel parses a label in the key=value format
+func ParseLabel(label string) (key, value string, err error) {
+	key, value, ok := strings.Cut(label, "=")
+	if !ok {
+		return "", "", fmt.Errorf("invalid label %q, expected key=value", label)
+	}
+	if err = ValidateLabel(key, value); err != nil {
+		return "", "", err
+	}
+
+	return key, value, nil
+}
+
+// SetLabel sets a label on the basis. Labels are persisted
+// when the basis is saved.
+func (b *Basis) SetLabel(key, value string) error {
+	if err := ValidateLabel(key, value); err != nil {
+		return err
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.basis.Metadata == nil {
+		b.basis.Metadata = &vagrant_plugin_sdk.Args_MetadataSet{}
+	}
+	if b.basis.Metadata.Metadata == nil {
+		b.basis.Metadata.Metadata = map[string]string{}
+	}
+	b.basis.Metadata.Metadata[basisLabelPrefix+key] = value
+
+	return nil
+}
+
+// GetLabels returns the labels set on the basis
+func (b *Basis) GetLabels() map[string]string {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	return basisLabels(b.basis)
+}
+
+// RemoveLabel removes a label from the basis. The removal
+// is persisted when the basis is saved.
+func (b *Basis) RemoveLabel(key string) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.basis.Metadata != nil {
+		delete(b.basis.Metadata.Metadata, basisLabelPrefix+key)
+	}
+}
+
+// FindBasesByLabel returns all stored bases which have the
+// label. The label must be in the key=value format.
+func FindBasesByLabel(
+	ctx context.Context, // context for the requests
+	client *serverclient.VagrantClient, // client to vagrant server
+	label string, // label to match
+) ([]*vagrant_server.Basis, error) {
+	key, value, err := ParseLabel(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := client.ListBasis(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*vagrant_server.Basis{}
+	for _, ref := range list.Basis {
+		resp, err := client.GetBasis(ctx, &vagrant_server.GetBasisRequest{Basis: ref})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load basis %s: %w", ref.Name, err)
+		}
+		if v, ok := basisLabels(resp.Basis)[key]; ok && v == value {
+			result = append(result, resp.Basis)
+		}
+	}
+
+	return result, nil
+}
+
+// Extract the labels from the stored basis metadata
+func basisLabels(b *vagrant_server.Basis) map[string]string {
+	labels := map[string]string{}
+	if b == nil || b.Metadata == nil {
+		return labels
+	}
+	for k, v := range b.Metadata.Metadata {
+		if key, ok := strings.CutPrefix(k, basisLabelPrefix); ok {
+			labels[key] = v
+		}
+	}
+
+	return labels
+}
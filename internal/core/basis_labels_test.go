@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabel(t *testing.T) {
+	key, value, err := ParseLabel("env=prod")
+	require.NoError(t, err)
+	require.Equal(t, "env", key)
+	require.Equal(t, "prod", value)
+
+	for _, l := range []string{"env", "=prod", "env=prod ution", "-env=prod", "env=prod;"} {
+		_, _, err = ParseLabel(l)
+		require.Error(t, err, l)
+	}
+}
+
+func TestBasisLabels(t *testing.T) {
+	_, err := NewBasis(context.Background(),
+		WithBasisLabels(map[string]string{"bad key": "value"}))
+	require.Error(t, err)
+
+	b := TestBasis(t, WithBasisLabels(map[string]string{"team": "infra"}))
+	require.Equal(t, map[string]string{"team": "infra"}, b.GetLabels())
+
+	require.Error(t, b.SetLabel("env", "prod/east"))
+	require.NoError(t, b.SetLabel("env", "prod"))
+	require.NoError(t, b.SetLabel("tier", "web"))
+	b.RemoveLabel("tier")
+	require.NoError(t, b.Save())
+
+	require.NoError(t, b.Reload())
+	require.Equal(t, map[string]string{"team": "infra", "env": "prod"}, b.GetLabels())
+
+	bases, err := FindBasesByLabel(context.Background(), b.client, "env=prod")
+	require.NoError(t, err)
+	require.Len(t, bases, 1)
+	require.Equal(t, b.basis.ResourceId, bases[0].ResourceId)
+
+	bases, err = FindBasesByLabel(context.Background(), b.client, "env=dev")
+	require.NoError(t, err)
+	require.Empty(t, bases)
+
+	_, err = FindBasesByLabel(context.Background(), b.client, "env")
+	require.Error(t, err)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// closerTracker records the closers registered with the basis
+// and which have completed so closers abandoned by a shutdown
+// timeout can be reported
+type closerTracker struct {
+	done  []bool
+	names []string
+
+	m sync.Mutex
+}
+
+// Add a closer and return its index
+func (c *closerTracker) add(name string) int {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.names = append(c.names, name)
+	c.done = append(c.done, false)
+
+	return len(c.names) - 1
+}
+
+// Mark the closer as completed
+func (c *closerTracker) complete(i int) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.done[i] = true
+}
+
+// Names of closers which have not completed
+func (c *closerTracker) pending() []string {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	result := []string{}
+	for i, name := range c.names {
+		if !c.done[i] {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
+
+// Name of the closer function for reporting
+func closerName(fn func() error) string {
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	if f == nil {
+		return "unknown"
+	}
+	name := f.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
+}
+
+// Run the closers, abandoning any which have not completed
+// once the shutdown timeout is reached
+func (b *Basis) closeWithTimeout() error {
+	result := make(chan error, 1)
+	go func() { result <- b.cleaner.Close() }()
+
+	timer := time.NewTimer(b.closeTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+	}
+
+	abandoned := b.closers.pending()
+	b.logger.Error("basis shutdown timed out, abandoning remaining closers",
+		"timeout", b.closeTimeout,
+		"closers", abandoned,
+	)
+
+	err := multierror.Append(nil,
+		fmt.Errorf("%w after %s", ErrShutdownTimeout, b.closeTimeout))
+	for _, name := range abandoned {
+		err = multierror.Append(err, fmt.Errorf("abandoned closer %s", name))
+	}
+
+	return err
+}
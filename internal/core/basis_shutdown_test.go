@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisShutdownTimeout(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithShutdownTimeout(0))
+	require.Error(t, err)
+
+	b := TestBasis(t, WithShutdownTimeout(50*time.Millisecond))
+	block := make(chan struct{})
+	defer close(block)
+
+	closed := false
+	b.Closer(func() error {
+		closed = true
+		return nil
+	})
+	b.Closer(func() error {
+		<-block
+		return nil
+	})
+
+	start := time.Now()
+	err = b.Close()
+	require.ErrorIs(t, err, ErrShutdownTimeout)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.True(t, closed)
+	require.Contains(t, err.Error(), "abandoned closer core.TestBasisShutdownTimeout.func2")
+	require.NotContains(t, err.Error(), "func1")
+}
+
+func TestBasisShutdownTimeoutCompleted(t *testing.T) {
+	b := TestBasis(t, WithShutdownTimeout(time.Minute))
+	require.NoError(t, b.Close())
+	require.Empty(t, b.closers.pending())
+}
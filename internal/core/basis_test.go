@@ -4,11 +4,31 @@
 package core
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/protomappers"
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/hashicorp/vagrant/internal/config"
 	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+	"github.com/hashicorp/vagrant/internal/server/singleprocess"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestBasisPlugins(t *testing.T) {
@@ -55,6 +75,169 @@ func TestBasisPlugins(t *testing.T) {
 	}
 }
 
+func TestBasisMapperSet(t *testing.T) {
+	set, err := argmapper.NewFuncList(protomappers.All)
+	require.NoError(t, err)
+
+	b := TestBasis(t, WithMapperSet(set...))
+	require.Len(t, b.mappers, len(set)+len(Mappers))
+	require.Equal(t, set[0], b.mappers[0])
+}
+
+func TestBasisDetectHost(t *testing.T) {
+	hostMock := BuildTestHostPlugin("myhost", "")
+	hostMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(true, nil)
+	myhost := plugin.TestPlugin(t,
+		hostMock,
+		plugin.WithPluginName("myhost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myhost)))
+	d, err := b.DetectHost(b.ctx)
+	require.NoError(t, err)
+	require.Equal(t, "myhost", d.Name)
+	require.True(t, d.Detected)
+
+	h, err := b.Host()
+	require.NoError(t, err)
+	require.Equal(t, d.Host, h)
+}
+
+func TestBasisFactoryFallback(t *testing.T) {
+	myguest := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("myguest", ""),
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+
+	b := TestBasis(t,
+		WithFactoryFallback(func(typ component.Type, name string) (plugin.PluginRegistration, error) {
+			if typ != component.GuestType || name != "myguest" {
+				return nil, nil
+			}
+			return func(hclog.Logger) (*plugin.Plugin, error) {
+				return myguest, nil
+			}, nil
+		}),
+	)
+
+	c, err := b.component(b.ctx, component.GuestType, "myguest")
+	require.NoError(t, err)
+	require.NotNil(t, c)
+
+	_, err = b.component(b.ctx, component.HostType, "myhost")
+	require.Error(t, err)
+}
+
+func TestBasisPing(t *testing.T) {
+	b := TestBasis(t)
+	require.NoError(t, b.Ping(b.ctx))
+}
+
+func TestBasisConfig(t *testing.T) {
+	t.Setenv(config.EnvLabels, "source=env")
+
+	enabled := true
+	b := TestBasis(t,
+		WithGlobalConfig(&config.Config{
+			Runner: &config.Runner{Enabled: &enabled},
+			Labels: map[string]string{"source": "global", "global": "true"},
+		}),
+		WithConfig(&config.Config{
+			Labels: map[string]string{"source": "path", "path": "true"},
+		}),
+	)
+
+	cfg, err := b.Config()
+	require.NoError(t, err)
+	require.True(t, cfg.Runner.IsEnabled())
+	require.Equal(t, map[string]string{
+		"source": "env",
+		"global": "true",
+		"path":   "true",
+	}, cfg.Labels)
+}
+
+func TestBasisClone(t *testing.T) {
+	b := TestBasis(t)
+	c, err := b.Clone()
+	require.NoError(t, err)
+
+	require.Equal(t, b.client, c.client)
+	require.Equal(t, b.dir, c.dir)
+	require.Equal(t, len(b.mappers), len(c.mappers))
+	require.Equal(t, b.basis.ResourceId, c.basis.ResourceId)
+	require.NotSame(t, b.statebag, c.statebag)
+
+	require.NotSame(t, b.events, c.events)
+	require.NotSame(t, b.idempotent, c.idempotent)
+
+	// Closing the clone should leave the parent usable and
+	// must not modify the parent's server record
+	require.NoError(t, c.SetLabel("clone", "true"))
+	require.NoError(t, c.Close())
+	_, err = b.Plugins("host")
+	require.NoError(t, err)
+	require.NoError(t, b.Reload())
+	require.NotContains(t, b.GetLabels(), "clone")
+	require.NoError(t, b.Save())
+}
+
+func TestBasisCloneUI(t *testing.T) {
+	rec := &testRecordUI{}
+	b := TestBasis(t,
+		WithUI(rec),
+		WithMachineReadableUI(),
+		WithOutputFilter(func(l string) string { return l + "!" }),
+	)
+
+	// Count the UIs wrapping the provided UI
+	layers := func(ui terminal.UI) (n int) {
+		for ui != rec {
+			ui = ui.(wrappedUI).unwrapUI()
+			n++
+		}
+		return
+	}
+
+	c, err := b.Clone()
+	require.NoError(t, err)
+	defer c.Close()
+	cc, err := c.Clone()
+	require.NoError(t, err)
+	defer cc.Close()
+
+	require.Same(t, rec, c.uiBase)
+	require.Equal(t, layers(b.ui), layers(c.ui))
+	require.Equal(t, layers(b.ui), layers(cc.ui))
+
+	// Output is only filtered and formatted once
+	cc.ui.Output("booting")
+	require.Len(t, rec.lines, 1)
+	require.True(t, strings.HasSuffix(rec.lines[0], ",ui,output,booting!"), rec.lines[0])
+}
+
+func TestBasisCloneMappers(t *testing.T) {
+	custom, err := argmapper.NewFunc(func(int8) int16 { return 0 })
+	require.NoError(t, err)
+
+	b := TestBasis(t, WithMappers(custom))
+	c, err := b.Clone()
+	require.NoError(t, err)
+	defer c.Close()
+	require.Equal(t, []*argmapper.Func{custom}, c.optionMappers)
+	require.Equal(t, len(b.mappers), len(c.mappers))
+
+	// The mappers used by the basis do not determine the
+	// mappers provided to the clone
+	b.mappers = b.mappers[:1]
+	c, err = b.Clone()
+	require.NoError(t, err)
+	defer c.Close()
+	require.Equal(t, []*argmapper.Func{custom}, c.optionMappers)
+}
+
 // TODO: (sophia) the ConfigVagrant structure should be at a higher level than Machineconfigs
 // func TestBasisConfigedHost(t *testing.T) {
 // 	type test struct {
@@ -89,3 +272,487 @@ func TestBasisPlugins(t *testing.T) {
 // 		}
 // 	}
 // }
+
+func TestBasisDetectHostCache(t *testing.T) {
+	hostMock := BuildTestHostPlugin("myhost", "")
+	hostMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(true, nil)
+	myhost := plugin.TestPlugin(t,
+		hostMock,
+		plugin.WithPluginName("myhost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+	staleMock := BuildTestHostPlugin("stalehost", "")
+	staleMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(false, nil)
+	stalehost := plugin.TestPlugin(t,
+		staleMock,
+		plugin.WithPluginName("stalehost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myhost, stalehost)))
+	cachePath := b.dir.DataDir().Join(hostCacheFile).String()
+
+	// A stale cached host falls back to a full scan and
+	// rewrites the cache
+	require.NoError(t, os.WriteFile(cachePath, []byte("stalehost"), 0644))
+	d, err := b.DetectHost(b.ctx)
+	require.NoError(t, err)
+	require.Equal(t, "myhost", d.Name)
+	content, err := os.ReadFile(cachePath)
+	require.NoError(t, err)
+	require.Equal(t, "myhost", string(content))
+
+	// A valid cached host is used without a full scan
+	b.InvalidateHost()
+	staleMock.Calls = nil
+	d, err = b.DetectHost(b.ctx)
+	require.NoError(t, err)
+	require.Equal(t, "myhost", d.Name)
+	staleMock.AssertNotCalled(t, "Detect", mock.Anything)
+}
+
+func TestBasisHostSupports(t *testing.T) {
+	hostMock := BuildTestHostPlugin("myhost", "")
+	hostMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(true, nil)
+	hostMock.On("HasCapability", "nfs").Return(true, nil)
+	hostMock.On("HasCapability", "smb").Return(false, nil)
+	myhost := plugin.TestPlugin(t,
+		hostMock,
+		plugin.WithPluginName("myhost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myhost)))
+
+	ok, err := b.HostSupports("nfs")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = b.HostSupports("smb")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Without a detected host an error is returned
+	hostMock = BuildTestHostPlugin("otherhost", "")
+	hostMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(false, nil)
+	otherhost := plugin.TestPlugin(t,
+		hostMock,
+		plugin.WithPluginName("otherhost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+	b = TestBasis(t, WithPluginManager(plugin.TestManager(t, otherhost)))
+	_, err = b.HostSupports("nfs")
+	require.ErrorIs(t, err, ErrHostNotDetected)
+
+	// Without any host plugins installed an error is returned
+	b = TestBasis(t)
+	_, err = b.HostSupports("nfs")
+	require.ErrorIs(t, err, ErrNoHostFactory)
+	require.Contains(t, err.Error(), "no host plugins installed")
+	require.Equal(t, "no_host_plugins", NewErrorDetails(err).Code)
+}
+
+func TestBasisHostDetectOnce(t *testing.T) {
+	hostMock := BuildTestHostPlugin("myhost", "")
+	hostMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(true, nil)
+	myhost := plugin.TestPlugin(t,
+		hostMock,
+		plugin.WithPluginName("myhost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myhost)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h, err := b.Host()
+			require.NoError(t, err)
+			require.NotNil(t, h)
+		}()
+	}
+	wg.Wait()
+	hostMock.AssertNumberOfCalls(t, "Detect", 1)
+
+	// Invalidating starts a new detection
+	b.InvalidateHost()
+	_, err := b.Host()
+	require.NoError(t, err)
+	hostMock.AssertNumberOfCalls(t, "Detect", 2)
+}
+
+func TestBasisHostDetectOnceError(t *testing.T) {
+	hostMock := BuildTestHostPlugin("nohost", "")
+	hostMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(false, nil)
+	nohost := plugin.TestPlugin(t,
+		hostMock,
+		plugin.WithPluginName("nohost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, nohost)))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := b.Host()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var first error
+	for err := range errs {
+		require.Error(t, err)
+		if first == nil {
+			first = err
+		}
+		require.Same(t, first, err)
+	}
+	hostMock.AssertNumberOfCalls(t, "Detect", 1)
+}
+
+func TestBasisConfigLoader(t *testing.T) {
+	b := TestBasis(t,
+		WithConfig(&config.Config{
+			Labels: map[string]string{"source": "file"},
+		}),
+		WithBasisConfigLoader(func() (*config.Config, error) {
+			return &config.Config{
+				Labels: map[string]string{"source": "loader"},
+			}, nil
+		}),
+	)
+
+	cfg, err := b.Config()
+	require.NoError(t, err)
+	require.Equal(t, "loader", cfg.Labels["source"])
+
+	_, err = NewBasis(context.Background(),
+		WithBasisConfigLoader(func() (*config.Config, error) {
+			return nil, fmt.Errorf("remote unavailable")
+		}),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "remote unavailable")
+}
+
+func TestBasisMapperDebug(t *testing.T) {
+	type missing struct{}
+	fn := func(*missing) int32 { return 0 }
+
+	for _, debug := range []bool{false, true} {
+		opts := []BasisOption{}
+		if debug {
+			opts = append(opts, WithMapperDebug())
+		}
+		b := TestBasis(t, opts...)
+
+		var buf bytes.Buffer
+		log := hclog.New(&hclog.LoggerOptions{Output: &buf, Level: hclog.Info})
+
+		_, err := b.callDynamicFunc(context.Background(), log, fn, (*int32)(nil))
+		require.Error(t, err)
+		if debug {
+			require.Contains(t, buf.String(), "mapper debug: missing argument")
+			require.Contains(t, buf.String(), "mapper debug: converter attempted")
+		} else {
+			require.Empty(t, buf.String())
+		}
+	}
+}
+
+func TestBasisDynamicArgOverride(t *testing.T) {
+	b := TestBasis(t)
+	ui := &testStatusUI{status: &testStatus{}}
+	fn := func(u terminal.UI) int32 {
+		if u == ui {
+			return 1
+		}
+		return 0
+	}
+
+	result, err := b.callDynamicFunc(context.Background(), b.logger, fn, (*int32)(nil),
+		argmapper.Typed(ui))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result)
+
+	// Default UI is used when not overridden
+	result, err = b.callDynamicFunc(context.Background(), b.logger, fn, (*int32)(nil))
+	require.NoError(t, err)
+	require.Equal(t, int32(0), result)
+}
+
+func TestBasisConfigValidator(t *testing.T) {
+	var seen []string
+	requireLabel := func(key string) ConfigValidator {
+		return func(c *config.Config) error {
+			seen = append(seen, key)
+			if _, ok := c.Labels[key]; !ok {
+				return fmt.Errorf("label %q is required", key)
+			}
+			return nil
+		}
+	}
+
+	TestBasis(t,
+		WithConfig(&config.Config{Labels: map[string]string{"team": "ops"}}),
+		WithConfigValidator(requireLabel("team")),
+	)
+	require.Equal(t, []string{"team"}, seen)
+
+	seen = nil
+	_, err := NewBasis(context.Background(),
+		WithConfig(&config.Config{}),
+		WithConfigValidator(requireLabel("team")),
+		WithConfigValidator(requireLabel("owner")),
+	)
+	require.Error(t, err)
+	require.Equal(t, []string{"team", "owner"}, seen)
+	require.Contains(t, err.Error(), "configuration validation failed")
+	require.Contains(t, err.Error(), `label "team" is required`)
+	require.Contains(t, err.Error(), `label "owner" is required`)
+}
+
+func TestBasisNamePath(t *testing.T) {
+	b := TestBasis(t,
+		WithBasisRef(&vagrant_plugin_sdk.Ref_Basis{Name: "display name"}),
+	)
+	path := b.basis.Path
+	require.NotEqual(t, "display name", path)
+
+	require.NoError(t, b.Reload())
+	require.Equal(t, "display name", b.basis.Name)
+	require.Equal(t, path, b.basis.Path)
+
+	ref := b.Ref().(*vagrant_plugin_sdk.Ref_Basis)
+	require.Equal(t, "display name", ref.Name)
+	require.Equal(t, path, ref.Path)
+	require.Equal(t, filepath.Base(path), b.dataDirIdent())
+}
+
+func TestBasisString(t *testing.T) {
+	b := TestBasis(t)
+	b.basis.Projects = []*vagrant_plugin_sdk.Ref_Project{{Name: "one"}, {Name: "two"}}
+
+	require.Equal(t,
+		fmt.Sprintf("basis(name=%s, id=%s, projects=2)", b.basis.Name, b.basis.ResourceId),
+		b.String(),
+	)
+	require.Equal(t, "basis(name=, id=, projects=0)", (&Basis{}).String())
+}
+
+func TestBasisLoggerFields(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithLoggerFields("key"))
+	require.Error(t, err)
+	_, err = NewBasis(context.Background(), WithLoggerFields(1, "value"))
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	b := TestBasis(t,
+		WithLogger(hclog.New(&hclog.LoggerOptions{Output: &buf})),
+		WithLoggerFields("request_id", "abc"),
+	)
+
+	buf.Reset()
+	b.logger.Info("test message")
+	line := buf.String()
+	require.Contains(t, line, "request_id=abc")
+	require.Equal(t, 1, strings.Count(line, "basis_resource_id="+b.basis.ResourceId))
+
+	p, err := b.factory.NewProject(
+		WithBasis(b),
+		WithProjectRef(&vagrant_plugin_sdk.Ref_Project{
+			Name: "logged",
+			Path: t.TempDir(),
+		}),
+	)
+	require.NoError(t, err)
+	implied := p.logger.ImpliedArgs()
+	require.Contains(t, implied, "request_id")
+	require.Contains(t, implied, "basis_resource_id")
+}
+
+func TestBasisPath(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, basisConfigFilename),
+		[]byte(`labels = { team = "ops" }`), 0644))
+
+	b := TestBasis(t, WithBasisPath(root))
+	require.Equal(t, root, b.basis.Path)
+	require.Equal(t, filepath.Base(root), b.dataDirIdent())
+	require.NotNil(t, b.dir)
+	require.Equal(t, "ops", b.config.Labels["team"])
+
+	_, err := NewBasis(context.Background(), WithBasisPath(filepath.Join(root, "missing")))
+	require.Error(t, err)
+
+	// Configuration is optional
+	b = TestBasis(t, WithBasisPath(t.TempDir()))
+	require.Empty(t, b.config.Labels)
+}
+
+func TestBasisPluginEnv(t *testing.T) {
+	_, err := NewBasis(context.Background(),
+		WithPluginEnv(map[string]string{"BAD=NAME": "value"}))
+	require.Error(t, err)
+	_, err = NewBasis(context.Background(), WithPluginEnvMode(plugin.EnvMode(5)))
+	require.Error(t, err)
+
+	b := TestBasis(t,
+		WithPluginEnv(map[string]string{"HTTP_PROXY": "http://proxy"}),
+		WithPluginEnvMode(plugin.EnvReplace),
+		WithPluginEnvPassthrough("HOME"),
+	)
+	require.Equal(t, &plugin.Env{
+		Mode:        plugin.EnvReplace,
+		Passthrough: []string{"HOME"},
+		Vars:        map[string]string{"HTTP_PROXY": "http://proxy"},
+	}, b.pluginEnv)
+}
+
+func TestBasisEphemeral(t *testing.T) {
+	b := TestBasis(t)
+	require.NoError(t, b.SetLabel("closed", "true"))
+	require.NoError(t, b.Close())
+
+	result, err := b.client.FindBasis(context.Background(),
+		&vagrant_server.FindBasisRequest{
+			Basis: &vagrant_server.Basis{ResourceId: b.basis.ResourceId},
+		},
+	)
+	require.NoError(t, err)
+	_, saved := basisLabels(result.Basis)["closed"]
+	require.True(t, saved)
+
+	// Ephemeral bases are never saved to the server
+	client := singleprocess.TestServer(t)
+	factory := NewFactory(context.Background(), client, hclog.L(), plugin.TestManager(t), nil)
+	b, err = factory.NewBasis("",
+		WithClient(client),
+		WithBasisRef(&vagrant_plugin_sdk.Ref_Basis{Name: "ephemeral", Path: t.TempDir()}),
+		WithEphemeral(),
+	)
+	require.NoError(t, err)
+	require.Empty(t, b.basis.ResourceId)
+	require.NoError(t, b.SetLabel("closed", "true"))
+	require.NoError(t, b.Close())
+
+	list, err := client.ListBasis(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	for _, ref := range list.Basis {
+		require.NotEqual(t, "ephemeral", ref.Name)
+	}
+}
+
+func TestBasisCancelledContext(t *testing.T) {
+	client := server.TestServer(t, singleprocess.TestImpl(t))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Server requests made while loading the basis use the basis context
+	_, err := NewBasis(ctx,
+		WithClient(client),
+		WithBasisResourceId("BASIS_ID"),
+	)
+	require.Error(t, err)
+	require.Equal(t, codes.Canceled, status.Code(err))
+
+	// Server requests made during initialization use the basis context
+	b, err := NewBasis(ctx,
+		WithClient(client),
+		WithPluginManager(plugin.TestManager(t)),
+		WithBasisRef(&vagrant_plugin_sdk.Ref_Basis{Name: "cancelled", Path: t.TempDir()}),
+	)
+	require.NoError(t, err)
+	err = b.Init()
+	require.Error(t, err)
+	require.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestBasisReindex(t *testing.T) {
+	b := TestBasis(t)
+
+	// Load a project within this process
+	loaded, err := b.factory.NewProject(
+		WithBasis(b),
+		WithProjectRef(&vagrant_plugin_sdk.Ref_Project{
+			Basis: b.Ref().(*vagrant_plugin_sdk.Ref_Basis),
+			Name:  "loaded",
+			Path:  t.TempDir(),
+		}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, loaded.Save())
+
+	// Add a project on the server from outside the basis
+	result, err := b.client.UpsertProject(context.Background(),
+		&vagrant_server.UpsertProjectRequest{
+			Project: &vagrant_server.Project{
+				Basis: b.Ref().(*vagrant_plugin_sdk.Ref_Basis),
+				Name:  "added",
+				Path:  t.TempDir(),
+			},
+		},
+	)
+	require.NoError(t, err)
+	_, ok := b.factory.fetch(result.Project.ResourceId)
+	require.False(t, ok)
+
+	require.NoError(t, b.Reindex())
+	require.Len(t, b.basis.Projects, 2)
+
+	// The added project is loaded and the existing project is untouched
+	added, ok := b.factory.fetch(result.Project.ResourceId)
+	require.True(t, ok)
+	require.Equal(t, "added", added.(*Project).project.Name)
+	existing, ok := b.factory.fetch(loaded.project.ResourceId)
+	require.True(t, ok)
+	require.Same(t, loaded, existing)
+	require.False(t, loaded.Closed())
+}
+
+// testFlagsCommand is a command with a required flag
+type testFlagsCommand struct {
+	plugin.TestPluginWithFakeBroker
+}
+
+func (c *testFlagsCommand) ExecuteFunc([]string) interface{} {
+	return func() int32 { return 0 }
+}
+
+func (c *testFlagsCommand) CommandInfoFunc() interface{} {
+	return func() *component.CommandInfo {
+		return &component.CommandInfo{
+			Name: "snapshot",
+			Flags: []*component.CommandFlag{
+				{LongName: "name", Type: component.FlagString | FlagRequired},
+			},
+		}
+	}
+}
+
+func TestBasisRunInit(t *testing.T) {
+	p := plugin.TestPlugin(t,
+		&testFlagsCommand{},
+		plugin.WithPluginName("snapshot"),
+		plugin.WithPluginTypes(component.CommandType),
+		plugin.WithPluginOptions(map[component.Type]interface{}{
+			component.CommandType: &component.CommandOptions{Primary: true},
+		}),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, p)))
+
+	result, err := b.RunInit()
+	require.NoError(t, err)
+	require.Len(t, result.Commands, 1)
+	require.Equal(t, "snapshot", result.Commands[0].Name)
+	require.True(t, result.Commands[0].Primary)
+	require.Equal(t, vagrant_plugin_sdk.Command_Flag_STRING, result.Commands[0].Flags[0].Type)
+	require.Len(t, result.CommandFlags, 1)
+	require.Equal(t, "snapshot", result.CommandFlags[0].Command)
+	require.Equal(t, []string{"name"}, result.CommandFlags[0].Required)
+}
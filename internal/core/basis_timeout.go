@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Context for server requests made by the basis. While the
+// basis is being constructed requests are bounded by the
+// construction timeout, if set. The returned function must
+// be called once the request is complete.
+func (b *Basis) requestCtx() (context.Context, context.CancelFunc) {
+	if b.setupDeadline.IsZero() {
+		return b.ctx, func() {}
+	}
+
+	return context.WithDeadline(b.ctx, b.setupDeadline)
+}
+
+// Complete construction of the basis. Requests are no longer
+// bounded by the construction timeout. If construction failed
+// because the timeout was reached, the error notes the timeout.
+func (b *Basis) finishSetup(err error) error {
+	deadline := b.setupDeadline
+	b.setupDeadline = time.Time{}
+	if err == nil || deadline.IsZero() || time.Now().Before(deadline) {
+		return err
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded {
+		return fmt.Errorf("%w: not completed within %s: %w",
+			ErrBasisTimeout, b.setupTimeout, err)
+	}
+
+	return err
+}
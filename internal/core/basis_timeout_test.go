@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+	"github.com/hashicorp/vagrant/internal/server/singleprocess"
+)
+
+// Server which never responds to basis updates
+type unresponsiveServer struct {
+	vagrant_server.VagrantServer
+}
+
+func (s *unresponsiveServer) UpsertBasis(
+	ctx context.Context,
+	req *vagrant_server.UpsertBasisRequest,
+) (*vagrant_server.UpsertBasisResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (s *unresponsiveServer) FindBasis(
+	ctx context.Context,
+	req *vagrant_server.FindBasisRequest,
+) (*vagrant_server.FindBasisResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestBasisTimeout(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithBasisTimeout(0))
+	require.Error(t, err)
+
+	client := server.TestServer(t, &unresponsiveServer{singleprocess.TestImpl(t)})
+	b, err := NewBasis(context.Background(),
+		WithClient(client),
+		WithPluginManager(plugin.TestManager(t)),
+		WithBasisRef(&vagrant_plugin_sdk.Ref_Basis{Name: "slow", Path: t.TempDir()}),
+		WithBasisTimeout(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = b.Init()
+	require.ErrorIs(t, err, ErrBasisTimeout)
+	require.Contains(t, err.Error(), "not completed within 100ms")
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// Requests after construction are no longer bounded
+	ctx, cancel := b.requestCtx()
+	defer cancel()
+	_, ok := ctx.Deadline()
+	require.False(t, ok)
+
+	// The timeout applies to basis lookups regardless of the
+	// order of the options
+	start = time.Now()
+	_, err = NewBasis(context.Background(),
+		WithClient(client),
+		WithBasisResourceId("BASIS_ID"),
+		WithBasisTimeout(100*time.Millisecond),
+	)
+	require.ErrorIs(t, err, ErrBasisTimeout)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
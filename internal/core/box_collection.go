@@ -54,9 +54,23 @@ func NewBoxCollection(basis *Basis, dir string, logger hclog.Logger) (bc *BoxCol
 //     actual box provider in the untarred box.
 //   - BoxUnpackageFailure - An invalid tar file.
 func (b *BoxCollection) Add(p path.Path, name, version, metadataURL string, force bool, providers ...string) (box core.Box, err error) {
-	if _, err := os.Stat(p.String()); err != nil {
+	info, err := os.Stat(p.String())
+	if err != nil {
 		return nil, fmt.Errorf("Could not add box, unable to find path %s", p.String())
 	}
+	size := info.Size()
+
+	progress, err := newProgressTracker(b.basis.progress, fmt.Sprintf("box add %s", name))
+	if err != nil {
+		return nil, err
+	}
+	progress.bytes(ProgressPhaseStarted, 0, 0, size)
+	defer func() {
+		if err != nil {
+			progress.phase(ProgressPhaseFailed, -1)
+		}
+	}()
+
 	exists, err := b.Find(name, version, providers...)
 	if err != nil {
 		return nil, err
@@ -78,6 +92,7 @@ func (b *BoxCollection) Add(p path.Path, name, version, metadataURL string, forc
 	} // delete tempdir when finished
 	defer os.RemoveAll(tempDir)
 	b.logger.Debug("Unpacking box")
+	progress.bytes(ProgressPhaseExtracting, 0, 0, size)
 	boxFile, err := os.Open(p.String())
 	if err != nil {
 		return nil, err
@@ -136,6 +151,7 @@ func (b *BoxCollection) Add(p path.Path, name, version, metadataURL string, forc
 		}
 	}
 
+	progress.bytes(ProgressPhaseInstalling, 50, size, size)
 	destDir := filepath.Join(b.directory, b.generateDirectoryName(name), version, provider)
 	b.logger.Debug("Box directory: %s", destDir)
 	os.MkdirAll(destDir, 0755)
@@ -180,6 +196,7 @@ func (b *BoxCollection) Add(p path.Path, name, version, metadataURL string, forc
 		}),
 	)
 	newBox.Save()
+	progress.bytes(ProgressPhaseCompleted, 100, size, size)
 	return newBox, nil
 }
 
@@ -124,6 +124,41 @@ func TestAddBadProviders(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestAddReportsProgress(t *testing.T) {
+	bc := newBoxCollection(t)
+	events := []*ProgressEvent{}
+	bc.basis.progress = ProgressReporterFunc(func(e *ProgressEvent) {
+		events = append(events, e)
+	})
+
+	td, err := ioutil.TempDir("/tmp", "box")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(td) })
+
+	testBoxPath := generateTestBox(t, td, bc.basis)
+	_, err = bc.Add(path.NewPath(testBoxPath), "test/box", "1.2.3", "", true)
+	require.NoError(t, err)
+
+	phases := []string{}
+	for _, e := range events {
+		require.Equal(t, events[0].OperationID, e.OperationID)
+		phases = append(phases, e.Phase)
+	}
+	require.NotEmpty(t, events[0].OperationID)
+	require.Equal(t, []string{
+		ProgressPhaseStarted,
+		ProgressPhaseExtracting,
+		ProgressPhaseInstalling,
+		ProgressPhaseCompleted,
+	}, phases)
+	require.Equal(t, events[0].TotalBytes, events[len(events)-1].Bytes)
+
+	events = []*ProgressEvent{}
+	_, err = bc.Add(path.NewPath(testBoxPath), "test/box", "1.2.4", "", true, "vmware")
+	require.Error(t, err)
+	require.Equal(t, ProgressPhaseFailed, events[len(events)-1].Phase)
+}
+
 func TestAll(t *testing.T) {
 	bc := newBoxCollection(t)
 	boxes, err := bc.All()
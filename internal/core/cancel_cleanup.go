@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+)
+
+// Default time allowed for each cleanup callback to complete
+const defaultCancelCleanupTimeout = 30 * time.Second
+
+// CancelCleanupFunc rolls back partial changes made by a command
+// which was cancelled. The provided context is not the cancelled
+// command context and will be cancelled when the cleanup timeout
+// is reached.
+type CancelCleanupFunc = commandargs.CancelCleanupFunc
+
+// CancelCleanup collects callbacks registered while running a
+// command. A collector is provided to commands as a typed argument.
+// If the command is cancelled, the callbacks are run in reverse
+// order of registration before the cancellation error is returned.
+// Plugins running in their own process request
+// commandargs.CancelCleanup, and their callbacks are called over
+// gRPC.
+type CancelCleanup struct {
+	fns []CancelCleanupFunc
+
+	m sync.Mutex
+}
+
+// NewCancelCleanup creates a new empty cleanup collector
+func NewCancelCleanup() *CancelCleanup {
+	return &CancelCleanup{fns: []CancelCleanupFunc{}}
+}
+
+// Register adds a callback to be run if the command is cancelled
+func (c *CancelCleanup) Register(fn CancelCleanupFunc) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.fns = append(c.fns, fn)
+}
+
+// Run all registered callbacks. Each callback is given its
+// own context which times out after the given duration.
+func (c *CancelCleanup) run(timeout time.Duration) error {
+	c.m.Lock()
+	fns := make([]CancelCleanupFunc, len(c.fns))
+	copy(fns, c.fns)
+	c.m.Unlock()
+
+	var result error
+	for i := len(fns) - 1; i >= 0; i-- {
+		if err := runCancelCleanupFunc(fns[i], timeout); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result
+}
+
+// Run a single callback, giving up if it does not complete
+// before the timeout
+func runCancelCleanupFunc(fn CancelCleanupFunc, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fn(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("cleanup did not complete: %w", ctx.Err())
+	}
+}
+
+// Run cleanup callbacks for a cancelled command and return
+// the cancellation error
+func (b *Basis) cancelCleanup(ctx context.Context, c *CancelCleanup) error {
+	b.logger.Info("command was cancelled, running cleanup callbacks")
+
+	timeout := b.cancelTimeout
+	if timeout == 0 {
+		timeout = defaultCancelCleanupTimeout
+	}
+
+	if err := c.run(timeout); err != nil {
+		b.logger.Warn("cleanup after cancellation failed",
+			"error", err,
+		)
+
+		return fmt.Errorf("command cancelled: %w (cleanup failed: %s)", ctx.Err(), err)
+	}
+
+	return fmt.Errorf("command cancelled: %w", ctx.Err())
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelCleanup(t *testing.T) {
+	c := NewCancelCleanup()
+	order := []int{}
+	c.Register(func(context.Context) error {
+		order = append(order, 1)
+		return nil
+	})
+	c.Register(func(context.Context) error {
+		order = append(order, 2)
+		return errors.New("rollback failed")
+	})
+
+	err := c.run(time.Second)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rollback failed")
+	require.Equal(t, []int{2, 1}, order)
+}
+
+func TestCancelCleanupTimeout(t *testing.T) {
+	c := NewCancelCleanup()
+	c.Register(func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	start := time.Now()
+	err := c.run(10 * time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestBasisCancelCleanup(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithCancelCleanupTimeout(0))
+	require.Error(t, err)
+
+	b := TestBasis(t, WithCancelCleanupTimeout(time.Second))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	c := NewCancelCleanup()
+	c.Register(func(ctx context.Context) error {
+		called = true
+		require.NoError(t, ctx.Err())
+		return nil
+	})
+
+	err = b.cancelCleanup(ctx, c)
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, called)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/core"
+)
+
+// CapabilityLister is implemented by capability platform
+// components which are able to list the names of the
+// capabilities they support. The plugin SDK does not define
+// a listing call, so components loaded through plugins only
+// support probing named capabilities with HasCapability.
+type CapabilityLister interface {
+	core.CapabilityPlatform
+	Capabilities() ([]string, error)
+}
+
+// ComponentCapabilities returns the names of the capabilities
+// supported by the component. Components which list their
+// capabilities return the full list. Otherwise each of the
+// given candidate names is checked with HasCapability and the
+// supported names are returned. If the component does not list
+// its capabilities and no candidates are given, or the component
+// is not a capability platform, ErrCapabilitiesUnsupported is
+// returned.
+func (b *Basis) ComponentCapabilities(
+	typ component.Type, // type of component
+	name string, // name of the component
+	candidates ...string, // capability names to probe
+) ([]string, error) {
+	c, err := b.component(b.ctx, typ, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var caps []string
+	switch v := c.Value.(type) {
+	case CapabilityLister:
+		if caps, err = v.Capabilities(); err != nil {
+			return nil, fmt.Errorf("failed to list capabilities of %s %s: %w",
+				typ.String(), name, err)
+		}
+	case core.CapabilityPlatform:
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("%s %s: %w", typ.String(), name, ErrCapabilitiesUnsupported)
+		}
+		for _, capName := range candidates {
+			ok, err := v.HasCapability(capName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check capability %s of %s %s: %w",
+					capName, typ.String(), name, err)
+			}
+			if ok {
+				caps = append(caps, capName)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%s %s: %w", typ.String(), name, ErrCapabilitiesUnsupported)
+	}
+
+	result := make([]string, 0, len(caps))
+	seen := map[string]struct{}{}
+	for _, capName := range caps {
+		if _, ok := seen[capName]; ok {
+			continue
+		}
+		seen[capName] = struct{}{}
+		result = append(result, capName)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
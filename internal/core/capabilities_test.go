@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/stretchr/testify/require"
+)
+
+type testCapabilityGuestPlugin struct {
+	*TestGuestPlugin
+	caps []string
+}
+
+func (p *testCapabilityGuestPlugin) Capabilities() ([]string, error) {
+	return p.caps, nil
+}
+
+func TestBasisComponentCapabilities(t *testing.T) {
+	myguest := plugin.TestPlugin(t,
+		&testCapabilityGuestPlugin{
+			BuildTestGuestPlugin("myguest", ""),
+			[]string{"mount_nfs", "change_hostname", "mount_nfs"},
+		},
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myguest)))
+
+	caps, err := b.ComponentCapabilities(component.GuestType, "myguest")
+	require.NoError(t, err)
+	require.Equal(t, []string{"change_hostname", "mount_nfs"}, caps)
+}
+
+func TestBasisComponentCapabilitiesUnsupported(t *testing.T) {
+	myguest := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("myguest", ""),
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myguest)))
+
+	_, err := b.ComponentCapabilities(component.GuestType, "myguest")
+	require.ErrorIs(t, err, ErrCapabilitiesUnsupported)
+}
+
+func TestBasisComponentCapabilitiesProbe(t *testing.T) {
+	guest := BuildTestGuestPlugin("myguest", "")
+	guest.On("HasCapability", "mount_nfs").Return(true, nil)
+	guest.On("HasCapability", "mount_smb").Return(false, nil)
+	guest.On("HasCapability", "change_hostname").Return(true, nil)
+	myguest := plugin.TestPlugin(t,
+		guest,
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myguest)))
+
+	caps, err := b.ComponentCapabilities(component.GuestType, "myguest",
+		"mount_nfs", "mount_smb", "change_hostname")
+	require.NoError(t, err)
+	require.Equal(t, []string{"change_hostname", "mount_nfs"}, caps)
+}
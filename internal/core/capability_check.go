@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// CapabilityMissingMode controls the behavior when an operation
+// requires a capability which is not supported
+type CapabilityMissingMode uint
+
+const (
+	CapabilityMissingError CapabilityMissingMode = iota // abort with ErrCapabilityUnsupported
+	CapabilityMissingSkip                               // skip the operation
+)
+
+func (m CapabilityMissingMode) String() string {
+	switch m {
+	case CapabilityMissingSkip:
+		return "skip"
+	default:
+		return "error"
+	}
+}
+
+// Parse the capability missing mode from the configuration
+func parseCapabilityMissingMode(v string) (CapabilityMissingMode, error) {
+	switch v {
+	case "", "error":
+		return CapabilityMissingError, nil
+	case "skip":
+		return CapabilityMissingSkip, nil
+	default:
+		return CapabilityMissingError, fmt.Errorf(
+			"invalid capability on_missing value %q, must be \"error\" or \"skip\"", v)
+	}
+}
+
+// CapabilityRequirement is a capability which must be supported
+// by the host or the target's provider for an operation to run
+type CapabilityRequirement struct {
+	Operation  string         // operation type requiring the capability
+	Type       component.Type // type of component providing the capability
+	Capability string         // name of the required capability
+}
+
+// CapabilityUnsupportedError is returned when a component does
+// not support a capability required by an operation
+type CapabilityUnsupportedError struct {
+	Operation  string         // operation requiring the capability
+	Capability string         // name of the missing capability
+	Type       component.Type // type of component checked
+	Component  string         // name of the component checked
+}
+
+// Error implements error
+func (e *CapabilityUnsupportedError) Error() string {
+	return fmt.Sprintf("%s %s does not support capability %q required by %s",
+		e.Type.String(), e.Component, e.Capability, e.Operation)
+}
+
+// Unwrap allows matching ErrCapabilityUnsupported
+func (e *CapabilityUnsupportedError) Unwrap() error {
+	return ErrCapabilityUnsupported
+}
+
+// Check the capabilities required by the operation before it is
+// run. If a capability is missing and the basis is configured to
+// skip, the skip is reported to the UI and true is returned.
+// Otherwise an error is returned for the missing capability.
+func (b *Basis) preflightOperation(s scope, op operation) (skip bool, err error) {
+	return b.preflight(s, operationName(op), true)
+}
+
+// Check the capabilities required by the command before it is
+// run. Provider capabilities are only checked for commands run
+// on a target since commands run on a project or basis do not
+// have a provider to check.
+func (b *Basis) preflightCommand(s scope, command string) (skip bool, err error) {
+	_, isTarget := s.(*Target)
+	return b.preflight(s, command, isTarget)
+}
+
+// Check the capabilities required for the named operation. Provider
+// requirements are ignored unless checkProvider is set.
+func (b *Basis) preflight(s scope, name string, checkProvider bool) (skip bool, err error) {
+	for _, req := range b.capRequired {
+		if req.Operation != name {
+			continue
+		}
+		if req.Type == component.ProviderType && !checkProvider {
+			continue
+		}
+
+		if err = b.checkCapability(s, req); err == nil {
+			continue
+		}
+
+		var capErr *CapabilityUnsupportedError
+		if !errors.As(err, &capErr) || b.capabilityMissingMode() != CapabilityMissingSkip {
+			return
+		}
+
+		b.logger.Info("skipping operation due to missing capability",
+			"operation", name,
+			"capability", capErr.Capability,
+			"component", capErr.Component,
+		)
+		if ui, uerr := s.UI(); uerr == nil {
+			ui.Output("Skipping %s: %s", name, capErr.Error(),
+				terminal.WithWarningStyle())
+		}
+
+		return true, nil
+	}
+
+	return
+}
+
+// Check that the required capability is supported by the
+// component providing it
+func (b *Basis) checkCapability(s scope, req CapabilityRequirement) error {
+	var (
+		componentName string
+		supported     bool
+		err           error
+	)
+
+	switch req.Type {
+	case component.HostType:
+		var d *HostDetection
+		if d, err = b.DetectHost(b.ctx); err != nil {
+			return fmt.Errorf("cannot check host capability %s: %w", req.Capability, err)
+		}
+		componentName = d.Name
+		supported, err = d.Host.HasCapability(req.Capability)
+	case component.ProviderType:
+		t, ok := s.(*Target)
+		if !ok {
+			return fmt.Errorf("cannot check provider capability %s for %s: operation is not run on a target",
+				req.Capability, req.Operation)
+		}
+		if componentName, err = t.ProviderName(); err != nil {
+			return err
+		}
+		p, perr := t.Provider()
+		if perr != nil {
+			return perr
+		}
+		supported, err = p.HasCapability(req.Capability)
+	default:
+		return fmt.Errorf("capability checks are not supported for %s components", req.Type.String())
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check capability %s of %s %s: %w",
+			req.Capability, req.Type.String(), componentName, err)
+	}
+	if !supported {
+		return &CapabilityUnsupportedError{
+			Operation:  req.Operation,
+			Capability: req.Capability,
+			Type:       req.Type,
+			Component:  componentName,
+		}
+	}
+
+	return nil
+}
+
+// Behavior when a required capability is missing. Operations
+// are aborted unless a mode has been set.
+func (b *Basis) capabilityMissingMode() CapabilityMissingMode {
+	if b.capMissing != nil {
+		return *b.capMissing
+	}
+
+	return CapabilityMissingError
+}
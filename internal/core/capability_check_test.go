@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/config"
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestBasisRequiredCapability(t *testing.T) {
+	hostMock := BuildTestHostPlugin("myhost", "")
+	hostMock.On("Detect", mock.AnythingOfType("*core.StateBag")).Return(true, nil)
+	hostMock.On("HasCapability", "nfs").Return(false, nil)
+	hostMock.On("HasCapability", "smb").Return(true, nil)
+	myhost := plugin.TestPlugin(t,
+		hostMock,
+		plugin.WithPluginName("myhost"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+	newBasis := func(opts ...BasisOption) (*Basis, *testRecordUI) {
+		rec := &testRecordUI{}
+		return TestBasis(t, append([]BasisOption{
+			WithPluginManager(plugin.TestManager(t, myhost)),
+			WithUI(rec),
+		}, opts...)...), rec
+	}
+
+	// Supported capabilities allow the operation to run
+	b, _ := newBasis(WithRequiredCapability("up", component.HostType, "smb"))
+	skip, err := b.preflightOperation(b, &testNamedOperation{})
+	require.NoError(t, err)
+	require.False(t, skip)
+
+	// Missing capabilities abort the operation by default
+	b, _ = newBasis(WithRequiredCapability("up", component.HostType, "nfs"))
+	_, _, err = b.wrapOperation(b, &testNamedOperation{})(context.Background(), hclog.NewNullLogger())
+	require.ErrorIs(t, err, ErrCapabilityUnsupported)
+	var capErr *CapabilityUnsupportedError
+	require.ErrorAs(t, err, &capErr)
+	require.Equal(t, "myhost", capErr.Component)
+	require.Equal(t, "nfs", capErr.Capability)
+	require.Equal(t, "capability_unsupported", NewErrorDetails(err).Code)
+
+	// Missing capabilities skip the operation when configured
+	b, rec := newBasis(
+		WithRequiredCapability("up", component.HostType, "nfs"),
+		WithConfig(&config.Config{Capabilities: &config.Capabilities{OnMissing: "skip"}}),
+	)
+	result, _, err := b.wrapOperation(b, &testNamedOperation{})(context.Background(), hclog.NewNullLogger())
+	require.NoError(t, err)
+	require.Nil(t, result)
+	require.Len(t, rec.lines, 1)
+	require.Contains(t, rec.lines[0], `Skipping up: Host myhost does not support capability "nfs" required by up`)
+
+	// Provider capabilities can only be checked for targets
+	b, _ = newBasis(
+		WithRequiredCapability("up", component.ProviderType, "nfs"),
+		WithCapabilityMissingMode(CapabilityMissingSkip),
+	)
+	_, err = b.preflightOperation(b, &testNamedOperation{})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrCapabilityUnsupported)
+
+	// Commands are checked before the command is loaded
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "up",
+		Component: &vagrant_server.Component{Name: "up"},
+	}
+	b, _ = newBasis(WithRequiredCapability("up", component.HostType, "nfs"))
+	_, err = b.Run(context.Background(), task)
+	require.ErrorIs(t, err, ErrCapabilityUnsupported)
+
+	b, rec = newBasis(
+		WithRequiredCapability("up", component.HostType, "nfs"),
+		WithCapabilityMissingMode(CapabilityMissingSkip),
+	)
+	_, err = b.Run(context.Background(), task)
+	require.NoError(t, err)
+	require.Len(t, rec.lines, 1)
+
+	// Provider capabilities are not checked for basis commands
+	b, _ = newBasis(WithRequiredCapability("up", component.ProviderType, "nfs"))
+	_, err = b.Run(context.Background(), task)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrCapabilityUnsupported)
+
+	_, err = NewBasis(context.Background(), WithRequiredCapability("up", component.CommandType, "nfs"))
+	require.Error(t, err)
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/hashicorp/vagrant-plugin-sdk"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// testArgsCommand is served over gRPC and runs the
+// provided function with the typed arguments it requests
+type testArgsCommand struct {
+	fn interface{}
+}
+
+func (c *testArgsCommand) ExecuteFunc([]string) interface{} {
+	return c.fn
+}
+
+func (c *testArgsCommand) CommandInfoFunc() interface{} {
+	return func() *component.CommandInfo {
+		return &component.CommandInfo{Name: "args"}
+	}
+}
+
+// Task running the test command
+var testArgsTask = &vagrant_server.Job_CommandOp{
+	Command:   "args",
+	Component: &vagrant_server.Component{Name: "args"},
+}
+
+// Create a basis with a command plugin served over gRPC which
+// runs the function
+func testArgsBasis(
+	t *testing.T,
+	ui terminal.UI, // UI for the basis, output is discarded when nil
+	fn interface{}, // function run by the command
+	opts ...BasisOption, // additional basis options
+) *Basis {
+	p := plugin.TestBuiltinPlugin(t, "args",
+		sdk.WithComponents(&testArgsCommand{fn: fn}),
+		sdk.WithMappers(commandargs.Mappers...),
+	)
+	if ui == nil {
+		ui = &testRecordUI{UI: terminal.NonInteractiveUI(context.Background())}
+	}
+
+	return TestBasis(t, append([]BasisOption{
+		WithUI(ui),
+		WithPluginManager(plugin.TestManager(t, p)),
+	}, opts...)...)
+}
+
+func TestCommandArgsWarnings(t *testing.T) {
+	b := testArgsBasis(t, nil, func(w commandargs.Warnings) int32 {
+		w.Add("option %q is deprecated", "foo")
+		w.Add("100% done")
+		return 0
+	})
+
+	warnings, err := b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.Equal(t, []string{"option \"foo\" is deprecated", "100% done"}, warnings)
+}
+
+func TestCommandArgsPrompter(t *testing.T) {
+	t.Run("interactive", func(t *testing.T) {
+		ui := &testInputUI{
+			UI:          terminal.NonInteractiveUI(context.Background()),
+			interactive: true,
+			response:    "value",
+		}
+		var value string
+		b := testArgsBasis(t, ui, func(p commandargs.Prompter) int32 {
+			var err error
+			if value, err = p.Input(&commandargs.InputRequest{Prompt: "Name?", Secret: true}); err != nil {
+				return 1
+			}
+			return 0
+		})
+
+		_, err := b.Run(context.Background(), testArgsTask)
+		require.NoError(t, err)
+		require.Equal(t, "value", value)
+		require.Len(t, ui.inputs, 1)
+		require.Equal(t, "Name?", ui.inputs[0].Prompt)
+		require.True(t, ui.inputs[0].Secret)
+	})
+
+	t.Run("non-interactive", func(t *testing.T) {
+		var inputErr error
+		b := testArgsBasis(t, nil, func(p commandargs.Prompter) int32 {
+			_, inputErr = p.Input(&commandargs.InputRequest{Prompt: "Name?"})
+			return 0
+		})
+
+		_, err := b.Run(context.Background(), testArgsTask)
+		require.NoError(t, err)
+		require.ErrorIs(t, inputErr, terminal.ErrNonInteractive)
+		require.Contains(t, inputErr.Error(), "Name?")
+	})
+}
+
+func TestCommandArgsArtifacts(t *testing.T) {
+	var addErr error
+	b := testArgsBasis(t, nil, func(a commandargs.Artifacts) int32 {
+		if err := a.Add("box.log", map[string]string{"kind": "log"}); err != nil {
+			return 1
+		}
+		addErr = a.Add("", nil)
+		return 0
+	})
+
+	_, err := b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.Error(t, addErr)
+
+	artifacts, err := b.Artifacts()
+	require.NoError(t, err)
+	require.Len(t, artifacts, 1)
+	require.Equal(t, "args", artifacts[0].Command)
+	require.True(t, filepath.IsAbs(artifacts[0].Path))
+	require.Equal(t, "box.log", filepath.Base(artifacts[0].Path))
+	require.Equal(t, map[string]string{"kind": "log"}, artifacts[0].Metadata)
+}
+
+func TestCommandArgsCancelCleanup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cleaned := make(chan struct{})
+	b := testArgsBasis(t, nil, func(c commandargs.CancelCleanup) int32 {
+		c.Register(func(ctx context.Context) error {
+			close(cleaned)
+			return nil
+		})
+		c.Register(func(ctx context.Context) error {
+			return errors.New("rollback failed")
+		})
+
+		// Callbacks are called once the command returns
+		cancel()
+		return 0
+	}, WithCancelCleanupTimeout(5*time.Second))
+
+	_, err := b.Run(ctx, testArgsTask)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Contains(t, err.Error(), "rollback failed")
+
+	select {
+	case <-cleaned:
+	default:
+		t.Fatal("cleanup callback was not called")
+	}
+}
+
+func TestCommandArgsStdin(t *testing.T) {
+	var echoed bytes.Buffer
+	var interactive bool
+	echo := func(in commandargs.Stdin) int32 {
+		if err := in.MakeRaw(); err != nil {
+			return 1
+		}
+		defer in.Restore()
+
+		interactive = in.Interactive()
+		if _, err := io.Copy(&echoed, in); err != nil {
+			return 1
+		}
+		return 0
+	}
+
+	input := strings.Repeat("hello\n", 10000)
+	b := testArgsBasis(t, nil, echo, WithStdin(strings.NewReader(input)))
+	_, err := b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.Equal(t, input, echoed.String())
+	require.False(t, interactive)
+
+	// Without input attached, reads return EOF immediately
+	echoed.Reset()
+	b = testArgsBasis(t, nil, echo)
+	_, err = b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.Empty(t, echoed.String())
+}
+
+func TestCommandArgsInvoker(t *testing.T) {
+	var warnings []string
+	var loopErr error
+	p := plugin.TestBuiltinPlugin(t, "args",
+		sdk.WithComponents(&testArgsCommand{fn: func(i commandargs.Invoker) int32 {
+			var err error
+			if warnings, err = i.Run("report", nil); err != nil {
+				return 1
+			}
+			_, loopErr = i.Run("args", nil)
+			return 0
+		}}),
+		sdk.WithMappers(commandargs.Mappers...),
+	)
+	report := plugin.TestPlugin(t,
+		&testReportingCommand{},
+		plugin.WithPluginName("report"),
+		plugin.WithPluginTypes(component.CommandType),
+	)
+	b := TestBasis(t,
+		WithUI(&testRecordUI{UI: terminal.NonInteractiveUI(context.Background())}),
+		WithPluginManager(plugin.TestManager(t, p, report)),
+	)
+
+	_, err := b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.Equal(t, []string{"deprecated"}, warnings)
+	require.Error(t, loopErr)
+	require.Contains(t, loopErr.Error(), "args -> args")
+}
+
+func TestCommandArgsProgress(t *testing.T) {
+	var m sync.Mutex
+	var phases []string
+	reporter := ProgressReporterFunc(func(e *ProgressEvent) {
+		m.Lock()
+		defer m.Unlock()
+		phases = append(phases, e.Phase)
+	})
+
+	var state *commandargs.ProgressState
+	b := testArgsBasis(t, nil, func(p commandargs.Progress) int32 {
+		if p.State() != nil {
+			return 1
+		}
+		p.Update("downloading", 40)
+		p.Update("extracting", -1)
+		state = p.State()
+		return 0
+	}, WithProgressReporter(reporter))
+
+	_, err := b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.Equal(t, &commandargs.ProgressState{Step: "extracting", Percent: -1}, state)
+	require.Equal(t, []string{
+		ProgressPhaseStarted,
+		"downloading",
+		"extracting",
+		ProgressPhaseCompleted,
+	}, phases)
+	require.Equal(t, state, b.LastProgress())
+}
+
+func TestCommandArgsResolvedConfig(t *testing.T) {
+	t.Setenv("VAGRANT_TEST_TEAM", "ops")
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, basisConfigFilename), []byte(`
+labels = { team = "$${VAGRANT_TEST_TEAM}" }
+
+plugins {
+  deny = ["blocked"]
+}
+
+component "provider" "configured" {
+  memory = 2048
+  tags   = ["a", "b"]
+}
+
+component "provider" "broken" {
+  nested {
+    memory = 2048
+  }
+}
+`), 0644))
+
+	var team string
+	var deny []string
+	var values map[string]interface{}
+	var brokenErr error
+	b := testArgsBasis(t, nil, func(env *commandargs.ResolvedConfig) int32 {
+		var err error
+		team, _ = env.Label("team")
+		_, deny, _ = env.PluginPolicy()
+		if values, err = env.ComponentConfig(component.ProviderType, "configured"); err != nil {
+			return 1
+		}
+		_, brokenErr = env.ComponentConfig(component.ProviderType, "broken")
+		return 0
+	}, WithBasisPath(root))
+
+	_, err := b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.Equal(t, "ops", team)
+	require.Equal(t, []string{"blocked"}, deny)
+	require.Equal(t, map[string]interface{}{
+		"memory": float64(2048),
+		"tags":   []interface{}{"a", "b"},
+	}, values)
+	require.Error(t, brokenErr)
+	require.Contains(t, brokenErr.Error(), "invalid configuration for Provider broken")
+}
+
+func TestCommandArgsCredentials(t *testing.T) {
+	var secret commandargs.Secret
+	var secretErr, missingErr error
+	b := testArgsBasis(t, nil, func(c commandargs.Credentials) int32 {
+		secret, secretErr = c.Get("api_key")
+		_, missingErr = c.Get("missing")
+		return 0
+	},
+		WithCredentialProvider(CredentialProviderFunc(
+			func(ctx context.Context, name string) (Secret, error) {
+				if name == "api_key" {
+					return "s3cr3t", nil
+				}
+				return "", ErrCredentialNotFound
+			},
+		)),
+	)
+
+	_, err := b.Run(context.Background(), testArgsTask)
+	require.NoError(t, err)
+	require.NoError(t, secretErr)
+	require.Equal(t, "s3cr3t", string(secret))
+	require.Equal(t, commandargs.Redacted, fmt.Sprintf("%v", secret))
+	require.ErrorIs(t, missingErr, commandargs.ErrCredentialNotFound)
+	require.Contains(t, missingErr.Error(), "missing")
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// BatchOption is used to set options for RunBatch
+type BatchOption func(*batchOptions)
+
+type batchOptions struct {
+	stopOnError bool // stop running tasks after a failure
+}
+
+// BatchStopOnError stops the batch once a task fails
+func BatchStopOnError() BatchOption {
+	return func(o *batchOptions) {
+		o.stopOnError = true
+	}
+}
+
+// RunBatch runs each of the tasks in order. Command components
+// are loaded once and reused by later tasks in the batch. Before
+// a component is reused its health is checked, and a component
+// which is unhealthy, or whose plugin is no longer reachable, is
+// reloaded. The exit code of each task which was run is returned
+// and the error includes each task which failed.
+func (b *Basis) RunBatch(
+	ctx context.Context, // context for the commands
+	tasks []*vagrant_server.Job_CommandOp, // tasks to run
+	opts ...BatchOption, // options for the batch
+) (exitCodes []int32, err error) {
+	o := &batchOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	components := &batchComponents{
+		basis:      b,
+		components: map[string]*Component{},
+	}
+	defer components.release()
+
+	exitCodes = make([]int32, 0, len(tasks))
+	for i, task := range tasks {
+		if ctx.Err() != nil {
+			return exitCodes, multierror.Append(err, ctx.Err())
+		}
+
+		task = b.resolveTaskAlias(task)
+		_, terr := b.wrapRun(func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+			return b.runCommandComponent(ctx, task, task.CliArgs, components.get)
+		})(ctx, task)
+		exitCodes = append(exitCodes, commandExitCode(terr))
+		if terr == nil {
+			continue
+		}
+
+		components.failed(task.Component.Name, terr)
+		err = multierror.Append(err, fmt.Errorf("task %d (%s): %w", i, task.Command, terr))
+		if o.stopOnError {
+			return
+		}
+	}
+
+	return
+}
+
+// batchComponents holds the command components loaded
+// while running a batch
+type batchComponents struct {
+	basis      *Basis
+	components map[string]*Component
+}
+
+// Returns the named command component, reusing the component
+// from a previous task if it is still healthy
+func (c *batchComponents) get(ctx context.Context, name string) (*Component, error) {
+	if cmd, ok := c.components[name]; ok {
+		health, err := c.basis.componentHealth(ctx, cmd)
+		if err == nil && health != HealthUnhealthy {
+			return cmd, nil
+		}
+
+		c.basis.logger.Warn("reloading unhealthy command component in batch",
+			"name", name,
+			"health", health.String(),
+			"error", err,
+		)
+		c.remove(name)
+	}
+
+	cmd, err := c.basis.commandComponent(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	c.components[name] = cmd
+
+	return cmd, nil
+}
+
+// Check the error from a failed task and remove the component
+// if its plugin is no longer reachable
+func (c *batchComponents) failed(name string, err error) {
+	if status.Code(err) != codes.Unavailable {
+		return
+	}
+
+	c.basis.logger.Warn("command component unavailable, reloading for next task",
+		"name", name,
+		"error", err,
+	)
+	c.remove(name)
+}
+
+// Release all the components once the batch is complete
+func (c *batchComponents) release() {
+	for _, cmd := range c.components {
+		cmd.Release()
+	}
+}
+
+// Close the component so it is not reused
+func (c *batchComponents) remove(name string) {
+	if cmd, ok := c.components[name]; ok {
+		cmd.Close()
+		delete(c.components, name)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestBasisRunBatch(t *testing.T) {
+	b := TestBasis(t)
+	tasks := []*vagrant_server.Job_CommandOp{
+		{Command: "first", Component: &vagrant_server.Component{Name: "first"}},
+		{Command: "second", Component: &vagrant_server.Component{Name: "second"}},
+	}
+
+	exitCodes, err := b.RunBatch(context.Background(), tasks)
+	require.Error(t, err)
+	require.Equal(t, []int32{1, 1}, exitCodes)
+	require.Contains(t, err.Error(), "task 0 (first)")
+	require.Contains(t, err.Error(), "task 1 (second)")
+
+	exitCodes, err = b.RunBatch(context.Background(), tasks, BatchStopOnError())
+	require.Error(t, err)
+	require.Equal(t, []int32{1}, exitCodes)
+}
+
+func TestBatchComponentsFailed(t *testing.T) {
+	c := &batchComponents{
+		basis:      TestBasis(t),
+		components: map[string]*Component{"up": {}},
+	}
+
+	// Command failures keep the component
+	c.failed("up", errors.New("command failed"))
+	require.Contains(t, c.components, "up")
+
+	// Unreachable plugins are removed so they are reloaded
+	c.failed("up", newRunError("up", nil, status.Error(codes.Unavailable, "plugin exited")))
+	require.NotContains(t, c.components, "up")
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// Maximum number of commands which can be chained by
+// commands running other commands
+const maxCommandChainDepth = 8
+
+// Context key for the chain of running commands
+type commandChainKey struct{}
+
+// Add the command to the chain of running commands. The chain
+// is stored in the context so commands run by other commands
+// can be checked for loops and excessive depth. Returns if the
+// command is being run by another command.
+func enterCommandChain(
+	ctx context.Context, // context for the command
+	command string, // command being run
+) (context.Context, bool, error) {
+	chain, _ := ctx.Value(commandChainKey{}).([]string)
+	for _, c := range chain {
+		if c == command {
+			return nil, false, fmt.Errorf("%w: %s",
+				ErrCommandChainLoop, strings.Join(append(chain, command), " -> "))
+		}
+	}
+	if len(chain) >= maxCommandChainDepth {
+		return nil, false, fmt.Errorf("%w: maximum depth of %d exceeded running %q",
+			ErrCommandChainDepth, maxCommandChainDepth, command)
+	}
+
+	chained := len(chain) > 0
+	chain = append(append([]string{}, chain...), command)
+
+	return context.WithValue(ctx, commandChainKey{}, chain), chained, nil
+}
+
+// CommandInvoker allows a running command to run other commands. It
+// is provided to commands as a typed argument. Commands run using
+// the invoker are run within the same scope, sharing the UI and job
+// information of the command which invoked them. Plugins running
+// in their own process request commandargs.Invoker.
+type CommandInvoker struct {
+	ctx  context.Context
+	task *vagrant_server.Job_CommandOp
+	run  func(context.Context, *vagrant_server.Job_CommandOp) ([]string, error)
+}
+
+func newCommandInvoker(
+	ctx context.Context, // context of the running command
+	task *vagrant_server.Job_CommandOp, // task of the running command
+	run func(context.Context, *vagrant_server.Job_CommandOp) ([]string, error), // scope run function
+) *CommandInvoker {
+	return &CommandInvoker{ctx: ctx, task: task, run: run}
+}
+
+// Run runs the command with the given arguments and returns any
+// warnings reported by the command. An error is returned if the
+// command is already running within the chain of commands or the
+// maximum chain depth is exceeded.
+func (c *CommandInvoker) Run(
+	command string, // command to run, including any subcommands
+	args *vagrant_plugin_sdk.Command_Arguments, // arguments for the command
+) ([]string, error) {
+	if args == nil {
+		args = &vagrant_plugin_sdk.Command_Arguments{}
+	}
+
+	task := proto.Clone(c.task).(*vagrant_server.Job_CommandOp)
+	task.Command = command
+	task.CliArgs = args
+	task.Component = &vagrant_server.Component{
+		Type: vagrant_server.Component_COMMAND,
+		Name: command,
+	}
+
+	return c.run(c.ctx, task)
+}
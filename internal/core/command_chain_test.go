@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestEnterCommandChain(t *testing.T) {
+	ctx, chained, err := enterCommandChain(context.Background(), "up")
+	require.NoError(t, err)
+	require.False(t, chained)
+
+	ctx, chained, err = enterCommandChain(ctx, "provision")
+	require.NoError(t, err)
+	require.True(t, chained)
+
+	_, _, err = enterCommandChain(ctx, "up")
+	require.ErrorIs(t, err, ErrCommandChainLoop)
+	require.Contains(t, err.Error(), "up -> provision -> up")
+}
+
+func TestCommandInvoker(t *testing.T) {
+	var ran []string
+	var run func(context.Context, *vagrant_server.Job_CommandOp) ([]string, error)
+	run = func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+		ctx, _, err := enterCommandChain(ctx, task.Command)
+		if err != nil {
+			return nil, err
+		}
+		ran = append(ran, task.Command)
+
+		// Each command runs another distinct command
+		next := fmt.Sprintf("cmd%d", len(ran))
+		return newCommandInvoker(ctx, task, run).Run(next, nil)
+	}
+
+	task := &vagrant_server.Job_CommandOp{Command: "start", JobId: "job"}
+	_, err := run(context.Background(), task)
+	require.ErrorIs(t, err, ErrCommandChainDepth)
+	require.Len(t, ran, maxCommandChainDepth)
+
+	// Invoked commands share the job of the invoking command
+	var invoked *vagrant_server.Job_CommandOp
+	invoker := newCommandInvoker(context.Background(), task,
+		func(_ context.Context, t *vagrant_server.Job_CommandOp) ([]string, error) {
+			invoked = t
+			return []string{"warning"}, nil
+		},
+	)
+	warnings, err := invoker.Run("provision", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"warning"}, warnings)
+	require.Equal(t, "job", invoked.JobId)
+	require.Equal(t, "provision", invoked.Command)
+	require.Equal(t, "provision", invoked.Component.Name)
+	require.NotNil(t, invoked.CliArgs)
+	require.Equal(t, "start", task.Command)
+}
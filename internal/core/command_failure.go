@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CommandFailureDomain is the error info domain used when
+// a command failure is sent as a status
+const CommandFailureDomain = "vagrant"
+
+// CommandFailure is a structured error returned by a command.
+// The code, message, and details are included in the result
+// of the run so clients can display the failure. Commands
+// run by plugins return the failure as a status with an
+// ErrorInfo detail using the CommandFailureDomain.
+type CommandFailure struct {
+	Code    string            // stable code for the failure (box_not_found)
+	Message string            // human readable message
+	Details map[string]string // additional details (suggestion)
+}
+
+// Error implements error
+func (f *CommandFailure) Error() string {
+	return f.Message
+}
+
+// GRPCStatus returns the failure as a status so it is
+// preserved when returned from a plugin
+func (f *CommandFailure) GRPCStatus() *status.Status {
+	st := status.New(codes.Unknown, f.Message)
+	if s, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   f.Code,
+		Domain:   CommandFailureDomain,
+		Metadata: f.Details,
+	}); err == nil {
+		st = s
+	}
+
+	return st
+}
+
+// String returns the failure formatted for display
+func (f *CommandFailure) String() string {
+	var b strings.Builder
+	b.WriteString("Error: " + f.Message)
+	if f.Code != "" {
+		b.WriteString("\n  code: " + f.Code)
+	}
+
+	keys := make([]string, 0, len(f.Details))
+	for k := range f.Details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf("\n  %s: %s", k, f.Details[k]))
+	}
+
+	return b.String()
+}
+
+// Extract the structured failure from the error returned by a
+// command. Returns nil if the command did not return a failure.
+func commandFailure(err error) *CommandFailure {
+	if err == nil {
+		return nil
+	}
+
+	var f *CommandFailure
+	if errors.As(err, &f) {
+		return f
+	}
+
+	// Failures from plugins are received as a status
+	var gs interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &gs) || gs.GRPCStatus() == nil {
+		return nil
+	}
+	st := gs.GRPCStatus()
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == CommandFailureDomain {
+			return &CommandFailure{
+				Code:    info.Reason,
+				Message: st.Message(),
+				Details: info.Metadata,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Output the command failure as a formatted error block
+func outputCommandFailure(ui terminal.UI, f *CommandFailure) {
+	if ui == nil || f == nil {
+		return
+	}
+
+	ui.Output("%s", f.String(), terminal.WithErrorStyle())
+}
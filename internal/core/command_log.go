@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// Starts timing a command and returns a function to be called
+// with the command's result once it has completed. The completion
+// is logged at info level regardless of outcome so the logs provide
+// a record of every command run.
+func commandCompletion(
+	log hclog.Logger, // logger including identifying fields
+	command string, // command being run
+) func(error) {
+	start := time.Now()
+	return func(err error) {
+		fields := []interface{}{
+			"command", command,
+			"duration", time.Since(start),
+			"exit_code", commandExitCode(err),
+		}
+		if err != nil {
+			fields = append(fields, "error", err)
+		}
+
+		log.Info("command completed", fields...)
+	}
+}
+
+// Exit code for the result of a command. Errors which do not
+// provide an exit code use an exit code of 1.
+func commandExitCode(err error) int32 {
+	if err == nil {
+		return 0
+	}
+
+	var cmdErr CommandError
+	if errors.As(err, &cmdErr) && cmdErr.ExitCode() != 0 {
+		return cmdErr.ExitCode()
+	}
+
+	return 1
+}
+
+// Logger for command completion within the basis
+func (b *Basis) commandLogger() hclog.Logger {
+	return b.logger.Named("command").With(
+		"basis_resource_id", b.basis.ResourceId,
+	)
+}
+
+// Logger for command completion within the project
+func (p *Project) commandLogger() hclog.Logger {
+	return p.logger.Named("command").With(
+		"basis_resource_id", p.basis.basis.ResourceId,
+		"project_resource_id", p.project.ResourceId,
+	)
+}
+
+// Logger for command completion within the target
+func (t *Target) commandLogger() hclog.Logger {
+	return t.logger.Named("command").With(
+		"basis_resource_id", t.project.basis.basis.ResourceId,
+		"project_resource_id", t.project.project.ResourceId,
+		"target_resource_id", t.target.ResourceId,
+	)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestCommandCompletion(t *testing.T) {
+	var buf bytes.Buffer
+	log := hclog.New(&hclog.LoggerOptions{Output: &buf, Level: hclog.Info})
+
+	commandCompletion(log, "up")(nil)
+	require.Contains(t, buf.String(), "command completed")
+	require.Contains(t, buf.String(), "command=up")
+	require.Contains(t, buf.String(), "exit_code=0")
+	require.Contains(t, buf.String(), "duration=")
+
+	buf.Reset()
+	commandCompletion(log, "up")(&runError{exitCode: 3})
+	require.Contains(t, buf.String(), "exit_code=3")
+
+	buf.Reset()
+	commandCompletion(log, "up")(errors.New("failed"))
+	require.Contains(t, buf.String(), "exit_code=1")
+	require.Contains(t, buf.String(), "error=failed")
+}
+
+func TestBasisRunLogsCompletion(t *testing.T) {
+	var buf bytes.Buffer
+	log := hclog.New(&hclog.LoggerOptions{Output: &buf, Level: hclog.Info})
+	b := TestBasis(t, WithLogger(log))
+
+	_, err := b.Run(b.ctx, &vagrant_server.Job_CommandOp{
+		Command:   "unknown",
+		Component: &vagrant_server.Component{Name: "unknown"},
+	})
+	require.Error(t, err)
+	require.Contains(t, buf.String(), "command completed")
+	require.Contains(t, buf.String(), "basis_resource_id="+b.basis.ResourceId)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// RunFunc runs a command task and returns any warnings reported
+// by the command
+type RunFunc func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error)
+
+// CommandMiddleware wraps the running of a command. The middleware
+// receives the task being run and can run logic before and after
+// calling next, or can stop the command by returning an error
+// without calling next.
+type CommandMiddleware func(next RunFunc) RunFunc
+
+// Wrap the run function with the command middleware. The first
+// middleware is the outermost.
+func (b *Basis) wrapRun(fn RunFunc) RunFunc {
+	if len(b.cmdMiddleware) == 0 {
+		return fn
+	}
+
+	// Recover the command separately so a panic raised by the
+	// command is not reported as a middleware panic
+	fn = b.recoverRun(fn, "command")
+	for i := len(b.cmdMiddleware) - 1; i >= 0; i-- {
+		fn = b.recoverRun(b.cmdMiddleware[i](fn), "command middleware")
+	}
+
+	return fn
+}
+
+// Convert any panic raised while running the function into
+// an error. The source describes what was run.
+func (b *Basis) recoverRun(fn RunFunc, source string) RunFunc {
+	return func(ctx context.Context, task *vagrant_server.Job_CommandOp) (warnings []string, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				b.logger.Error("panic during "+source,
+					"command", task.Command,
+					"panic", r,
+					"stack", string(debug.Stack()),
+				)
+
+				warnings = nil
+				err = fmt.Errorf("%s panic: %v", source, r)
+			}
+		}()
+
+		return fn(ctx, task)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestBasisCommandMiddleware(t *testing.T) {
+	var order []string
+	named := func(name string) CommandMiddleware {
+		return func(next RunFunc) RunFunc {
+			return func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+				order = append(order, name+" "+task.Command)
+				return next(ctx, task)
+			}
+		}
+	}
+	denied := errors.New("denied")
+	deny := func(next RunFunc) RunFunc {
+		return func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+			if task.Command == "destroy" {
+				return nil, denied
+			}
+			return next(ctx, task)
+		}
+	}
+	var started int
+	b := TestBasis(t,
+		WithCommandMiddleware(named("outer"), named("inner")),
+		WithCommandMiddleware(deny),
+		WithBasisHooks(BasisHooks{
+			BeforeOperation: func(context.Context, *Basis) error {
+				started++
+				return nil
+			},
+		}),
+	)
+
+	// Middleware is run in order around the command
+	_, err := b.Run(context.Background(), &vagrant_server.Job_CommandOp{
+		Command:   "unknown",
+		Component: &vagrant_server.Component{Name: "unknown"},
+	})
+	require.Error(t, err)
+	require.Equal(t, []string{"outer unknown", "inner unknown"}, order)
+	require.Equal(t, 1, started)
+
+	// Middleware can stop the command from running
+	_, err = b.Run(context.Background(), &vagrant_server.Job_CommandOp{
+		Command:   "destroy",
+		Component: &vagrant_server.Component{Name: "destroy"},
+	})
+	require.ErrorIs(t, err, denied)
+	require.Equal(t, 1, started)
+
+	// Batched commands are run through the middleware
+	order = nil
+	_, err = b.RunBatch(context.Background(), []*vagrant_server.Job_CommandOp{
+		{Command: "destroy", Component: &vagrant_server.Component{Name: "destroy"}},
+	})
+	require.ErrorIs(t, err, denied)
+	require.Equal(t, []string{"outer destroy", "inner destroy"}, order)
+	require.Equal(t, 1, started)
+
+	// Panics within middleware are returned as errors
+	b = TestBasis(t, WithCommandMiddleware(func(RunFunc) RunFunc {
+		return func(context.Context, *vagrant_server.Job_CommandOp) ([]string, error) {
+			panic("broken")
+		}
+	}))
+	_, err = b.Run(context.Background(), &vagrant_server.Job_CommandOp{
+		Command:   "unknown",
+		Component: &vagrant_server.Component{Name: "unknown"},
+	})
+	require.ErrorContains(t, err, "command middleware panic: broken")
+
+	// Panics within the command are not reported as middleware panics
+	b = TestBasis(t, WithCommandMiddleware(named("outer")))
+	_, err = b.wrapRun(func(context.Context, *vagrant_server.Job_CommandOp) ([]string, error) {
+		panic("crashed")
+	})(context.Background(), &vagrant_server.Job_CommandOp{Command: "up"})
+	require.EqualError(t, err, "command panic: crashed")
+}
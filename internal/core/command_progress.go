@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+)
+
+// Width of the rendered progress bar
+const progressBarWidth = 30
+
+// ProgressState is the most recent progress reported by a command
+type ProgressState = commandargs.ProgressState
+
+// Progress is provided to commands as a typed argument so plugins
+// can report the progress of long running work, like box downloads
+// or multi-step provisioning. Updates are rendered as a progress bar
+// when the UI supports live status output, and as plain text lines
+// otherwise. Each update is also sent to the basis progress reporter.
+// Reporting progress is optional. Plugins running in their own
+// process request commandargs.Progress.
+type Progress struct {
+	state   *ProgressState
+	last    string
+	status  terminal.Status
+	tracker *progressTracker
+	ui      terminal.UI
+
+	m sync.Mutex
+}
+
+// Create a new progress for a command which renders to the UI
+// and reports events using the tracker
+func newProgress(ui terminal.UI, tracker *progressTracker) *Progress {
+	return &Progress{
+		tracker: tracker,
+		ui:      ui,
+	}
+}
+
+// Update reports the current step and percent complete. A negative
+// percent indicates the completion of the step is unknown. Percents
+// above 100 are reported as 100.
+func (p *Progress) Update(step string, percent float64) {
+	if percent > 100 {
+		percent = 100
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.state = &ProgressState{Step: step, Percent: percent}
+	p.tracker.phase(step, percent)
+	p.render()
+}
+
+// State returns the most recent progress reported. If no progress
+// has been reported nil is returned.
+func (p *Progress) State() *ProgressState {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.state == nil {
+		return nil
+	}
+	state := *p.state
+
+	return &state
+}
+
+// Render the current state to the UI. Interactive UIs display
+// a live progress bar. Other UIs receive a line of text whenever
+// the displayed progress changes.
+func (p *Progress) render() {
+	if p.ui == nil {
+		return
+	}
+
+	if p.status == nil && p.ui.Interactive() && !p.ui.MachineReadable() {
+		p.status = p.ui.Status()
+	}
+	if p.status != nil {
+		p.status.Update(progressBar(p.state))
+		return
+	}
+
+	line := progressText(p.state)
+	if line == p.last {
+		return
+	}
+	p.last = line
+	p.ui.Output("%s", line)
+}
+
+// Stop rendering progress. The final state remains available.
+func (p *Progress) close() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.status == nil {
+		return
+	}
+	if p.state != nil && p.state.Percent >= 100 {
+		p.status.Step(terminal.StatusOK, p.state.Step)
+	}
+	p.status.Close()
+	p.status = nil
+}
+
+// Format the state as a progress bar
+func progressBar(s *ProgressState) string {
+	if s.Percent < 0 {
+		return s.Step
+	}
+
+	filled := int(s.Percent / 100 * progressBarWidth)
+	return fmt.Sprintf("[%s%s] %3.0f%% %s",
+		strings.Repeat("=", filled),
+		strings.Repeat(" ", progressBarWidth-filled),
+		s.Percent, s.Step)
+}
+
+// Format the state as a line of text
+func progressText(s *ProgressState) string {
+	if s.Percent < 0 {
+		return s.Step
+	}
+
+	return fmt.Sprintf("%s (%.0f%%)", s.Step, s.Percent)
+}
+
+// Stop rendering the progress of a command. The final state is
+// recorded for LastProgress unless the command was run by another
+// command.
+func (b *Basis) finishProgress(p *Progress, chained bool) {
+	p.close()
+	if chained {
+		return
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	b.lastProgress = p.State()
+}
+
+// LastProgress returns the final progress reported by the most
+// recent command run by the basis. If the command did not report
+// progress nil is returned.
+func (b *Basis) LastProgress() *ProgressState {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	return b.lastProgress
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+type testProgressUI struct {
+	testRecordUI
+
+	interactive bool
+	status      *testProgressStatus
+}
+
+func (u *testProgressUI) Interactive() bool {
+	return u.interactive
+}
+
+func (u *testProgressUI) Status() terminal.Status {
+	if u.status == nil {
+		u.status = &testProgressStatus{}
+	}
+	return u.status
+}
+
+type testProgressStatus struct {
+	updates []string
+	steps   []string
+	closed  bool
+}
+
+func (s *testProgressStatus) Update(msg string) {
+	s.updates = append(s.updates, msg)
+}
+
+func (s *testProgressStatus) Step(status, msg string) {
+	s.steps = append(s.steps, status+": "+msg)
+}
+
+func (s *testProgressStatus) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestProgress(t *testing.T) {
+	var m sync.Mutex
+	var events []*ProgressEvent
+	reporter := ProgressReporterFunc(func(e *ProgressEvent) {
+		m.Lock()
+		defer m.Unlock()
+		events = append(events, e)
+	})
+	provision := func(p *Progress) int32 {
+		p.Update("downloading box", 10)
+		p.Update("downloading box", 10.2)
+		p.Update("provisioning", -1)
+		p.Update("provisioning", 150)
+		return 0
+	}
+
+	// Non-interactive UIs receive text updates
+	ui := &testProgressUI{}
+	b := TestBasis(t, WithUI(ui), WithProgressReporter(reporter))
+	tracker, err := newProgressTracker(b.progress, "up")
+	require.NoError(t, err)
+	progress := newProgress(ui, tracker)
+	require.Nil(t, progress.State())
+
+	result, err := b.callDynamicFunc(context.Background(), b.logger, provision, (*int32)(nil),
+		argmapper.Typed(progress))
+	require.NoError(t, err)
+	require.Equal(t, int32(0), result)
+	progress.close()
+	require.Equal(t, []string{
+		"downloading box (10%)",
+		"provisioning",
+		"provisioning (100%)",
+	}, ui.lines)
+	require.Equal(t, &ProgressState{Step: "provisioning", Percent: 100}, progress.State())
+
+	// Each update is reported to the basis progress reporter
+	require.Len(t, events, 4)
+	require.Equal(t, "up", events[0].Operation)
+	require.Equal(t, "downloading box", events[0].Phase)
+	require.Equal(t, float64(100), events[3].Percent)
+
+	// Interactive UIs render a progress bar
+	ui = &testProgressUI{interactive: true}
+	progress = newProgress(ui, tracker)
+	progress.Update("downloading box", 50)
+	progress.Update("downloading box", 100)
+	progress.close()
+	require.Empty(t, ui.lines)
+	require.Equal(t, []string{
+		"[===============               ]  50% downloading box",
+		"[==============================] 100% downloading box",
+	}, ui.status.updates)
+	require.Equal(t, []string{"ok: downloading box"}, ui.status.steps)
+	require.True(t, ui.status.closed)
+}
+
+// testProgressCommand is a command which reports progress
+type testProgressCommand struct {
+	plugin.TestPluginWithFakeBroker
+}
+
+func (c *testProgressCommand) ExecuteFunc([]string) interface{} {
+	return func(p *Progress) int32 {
+		p.Update("working", 50)
+		return 0
+	}
+}
+
+func (c *testProgressCommand) CommandInfoFunc() interface{} {
+	return func() *component.CommandInfo {
+		return &component.CommandInfo{Name: "work"}
+	}
+}
+
+func TestProgressScopes(t *testing.T) {
+	work := plugin.TestPlugin(t,
+		&testProgressCommand{},
+		plugin.WithPluginName("work"),
+		plugin.WithPluginTypes(component.CommandType),
+	)
+	tp := TestProject(t, WithPluginManager(plugin.TestManager(t, work)))
+	tt := TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-progress", Name: "progress"})
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "work",
+		Component: &vagrant_server.Component{Name: "work"},
+	}
+
+	_, err := tp.Run(context.Background(), task)
+	require.NoError(t, err)
+	require.Equal(t, &ProgressState{Step: "working", Percent: 50}, tp.basis.LastProgress())
+
+	tp.basis.lastProgress = nil
+	_, err = tt.Run(context.Background(), task)
+	require.NoError(t, err)
+	require.Equal(t, &ProgressState{Step: "working", Percent: 50}, tp.basis.LastProgress())
+}
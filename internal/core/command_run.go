@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// commandRun describes how a command is run within a scope. The
+// basis, projects, and targets all run commands using the same
+// pipeline and only provide what differs between the scopes.
+type commandRun struct {
+	call      dynamicCaller      // calls functions within the scope
+	cmdLogger hclog.Logger       // logger for command completion
+	jobInfo   *component.JobInfo // job information of the scope
+	load      componentLoader    // loads the command component
+	logger    hclog.Logger       // logger of the scope
+	project   *Project           // project kept loaded while running, nil for the basis
+	rerun     RunFunc            // runs commands invoked by the command
+	scope     scope              // scope the command is run within
+	target    string             // name of the target the command is run on, if any
+	typed     []interface{}      // typed arguments provided by the scope
+	ui        terminal.UI        // UI for command output
+}
+
+// Function used to load a command component by name
+type componentLoader func(context.Context, string) (*Component, error)
+
+// Function used to call dynamic functions within a scope
+type dynamicCaller func(
+	ctx context.Context,
+	log hclog.Logger,
+	f interface{},
+	expectedType interface{},
+	args ...argmapper.Arg,
+) (interface{}, error)
+
+// Check if a failed command was caused by the plugin process
+// providing the command exiting
+func (b *Basis) commandCrash(
+	err error, // error from running the command
+	cmd *Component, // command component which was run
+	task *vagrant_server.Job_CommandOp, // task which was run
+) error {
+	if cmd.plugin != nil {
+		return b.pluginCrash(err, cmd.plugin)
+	}
+
+	return b.pluginLoadCrash(err, component.CommandType,
+		strings.Split(task.Component.Name, " ")[0])
+}
+
+// Run the task's command within the scope described by the run
+func (b *Basis) runCommandIn(
+	ctx context.Context, // context for the command
+	task *vagrant_server.Job_CommandOp, // task to run
+	r *commandRun, // scope specific parts of the run
+) (warnings []string, err error) {
+	task = b.resolveTaskAlias(task)
+	r.logger.Debug("running new command",
+		"command", task)
+
+	// Commands may be run by other commands, so ensure
+	// the chain of commands does not loop
+	ctx, chained, err := enterCommandChain(ctx, task.Command)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.hooks.beforeOperation(ctx, b); err != nil {
+		return nil, err
+	}
+
+	// Check required capabilities before the command is run
+	if skip, err := b.preflightCommand(r.scope, task.Command); skip || err != nil {
+		return nil, err
+	}
+
+	// Track the command so it can be cancelled
+	ctx, done := b.operations.track(ctx, r.jobInfo, task.Command)
+	defer done()
+	ctx = b.withEnvironment(ctx)
+	if r.project != nil {
+		defer b.projects.pin(r.project)()
+	}
+	if !chained {
+		defer b.output.begin()()
+	}
+
+	complete := commandCompletion(r.cmdLogger, task.Command)
+	defer func() { complete(err) }()
+	defer func() {
+		if !chained {
+			reportError(r.ui, err, task.Component.Name, task.Command)
+		}
+	}()
+	defer flushOutput(r.ui)
+
+	// Build the component to run
+	cmd, err := r.load(ctx, task.Component.Name)
+	if err != nil {
+		r.logger.Error("failed to build requested component",
+			"type", component.CommandType,
+			"name", task.Component.Name,
+			"error", err)
+
+		return nil, err
+	}
+
+	operation := task.Command
+	if r.target != "" {
+		operation = fmt.Sprintf("%s %s", task.Command, r.target)
+	}
+	tracker, err := newProgressTracker(b.progress, operation)
+	if err != nil {
+		return nil, err
+	}
+	tracker.phase(ProgressPhaseStarted, 0)
+	progress := newProgress(r.ui, tracker)
+	defer func() { b.finishProgress(progress, chained) }()
+
+	collector := NewWarnings()
+	artifacts := NewArtifacts()
+	cleanups := NewCancelCleanup()
+	defer func() {
+		warnings = collector.Warnings()
+		collector.display(r.ui)
+
+		if aerr := b.storeArtifacts(task.Command, artifacts); aerr != nil {
+			r.logger.Warn("failed to record command artifacts",
+				"error", aerr,
+			)
+		}
+	}()
+
+	invoker := newCommandInvoker(ctx, task, r.rerun)
+
+	stdin := newCommandStdin(b.stdin)
+	defer stdin.Restore()
+
+	typed := append(append([]interface{}{}, r.typed...), collector,
+		artifacts, NewPrompter(r.ui), cleanups, stdin, invoker, progress)
+
+	fn := cmd.Value.(component.Command).ExecuteFunc(
+		strings.Split(task.Command, " "))
+	result, err := r.call(ctx, r.logger, fn, (*int32)(nil),
+		argmapper.Typed(typed...),
+		argmapper.ConverterFunc(cmd.mappers...),
+	)
+
+	// Allow the command to roll back partial changes
+	if ctx.Err() != nil {
+		tracker.phase(ProgressPhaseFailed, -1)
+		return nil, b.cancelCleanup(ctx, cleanups)
+	}
+
+	if err != nil || result == nil || result.(int32) != 0 {
+		tracker.phase(ProgressPhaseFailed, -1)
+		err = b.commandCrash(err, cmd, task)
+		r.logger.Error("failed to execute command",
+			"type", component.CommandType,
+			"name", task.Component.Name,
+			"result", result,
+			"error", err)
+
+		cmdErr := newRunError(task.Command, result, err)
+		if err != nil && cmdErr.status == nil {
+			if st, ok := status.FromError(err); ok {
+				cmdErr.status = st.Proto()
+			}
+		}
+
+		return nil, cmdErr
+	}
+	tracker.phase(ProgressPhaseCompleted, 100)
+
+	return
+}
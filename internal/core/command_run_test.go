@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// testReportingCommand reports a warning, an artifact, and progress
+type testReportingCommand struct {
+	plugin.TestPluginWithFakeBroker
+}
+
+func (c *testReportingCommand) ExecuteFunc([]string) interface{} {
+	return func(w *Warnings, a *Artifacts, p *Progress) int32 {
+		w.Add("deprecated")
+		if err := a.Add("report.log", nil); err != nil {
+			return 1
+		}
+		p.Update("reporting", 50)
+		return 0
+	}
+}
+
+func (c *testReportingCommand) CommandInfoFunc() interface{} {
+	return func() *component.CommandInfo {
+		return &component.CommandInfo{Name: "report"}
+	}
+}
+
+func TestCommandRunScopes(t *testing.T) {
+	var m sync.Mutex
+	var phases []string
+	reporter := ProgressReporterFunc(func(e *ProgressEvent) {
+		m.Lock()
+		defer m.Unlock()
+		phases = append(phases, e.Operation+": "+e.Phase)
+	})
+
+	report := plugin.TestPlugin(t,
+		&testReportingCommand{},
+		plugin.WithPluginName("report"),
+		plugin.WithPluginTypes(component.CommandType),
+	)
+	tp := TestProject(t,
+		WithPluginManager(plugin.TestManager(t, report)),
+		WithProgressReporter(reporter),
+	)
+	tt := TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-report", Name: "reporter"})
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "report",
+		Component: &vagrant_server.Component{Name: "report"},
+	}
+
+	runs := map[string]func(context.Context, *vagrant_server.Job_CommandOp) ([]string, error){
+		"basis":   tp.basis.Run,
+		"project": tp.Run,
+		"target":  tt.Run,
+	}
+	for name, run := range runs {
+		t.Run(name, func(t *testing.T) {
+			phases = nil
+			tp.basis.lastProgress = nil
+			before, err := tp.basis.Artifacts()
+			require.NoError(t, err)
+
+			warnings, err := run(context.Background(), task)
+			require.NoError(t, err)
+			require.Equal(t, []string{"deprecated"}, warnings)
+
+			artifacts, err := tp.basis.Artifacts()
+			require.NoError(t, err)
+			require.Len(t, artifacts, len(before)+1)
+			require.Equal(t, "report", artifacts[len(before)].Command)
+			require.Equal(t, "report.log", filepath.Base(artifacts[len(before)].Path))
+
+			operation := "report"
+			if name == "target" {
+				operation = "report reporter"
+			}
+			require.Equal(t, []string{
+				operation + ": " + ProgressPhaseStarted,
+				operation + ": reporting",
+				operation + ": " + ProgressPhaseCompleted,
+			}, phases)
+			require.Equal(t, &ProgressState{Step: "reporting", Percent: 50}, tp.basis.LastProgress())
+		})
+	}
+}
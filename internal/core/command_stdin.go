@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// CommandStdin provides the user's input to commands. It is passed
+// to commands as a typed argument so interactive commands can read
+// input. When no input is attached to the basis, reads return
+// io.EOF immediately so non-interactive commands never block.
+// Plugins running in their own process request commandargs.Stdin,
+// which streams the input over gRPC.
+type CommandStdin struct {
+	r     io.Reader
+	state *term.State
+
+	m sync.Mutex
+}
+
+func newCommandStdin(r io.Reader) *CommandStdin {
+	return &CommandStdin{r: r}
+}
+
+// Read implements io.Reader
+func (s *CommandStdin) Read(p []byte) (int, error) {
+	if s.r == nil {
+		return 0, io.EOF
+	}
+
+	return s.r.Read(p)
+}
+
+// Interactive returns if the input is a terminal
+func (s *CommandStdin) Interactive() bool {
+	_, ok := s.terminal()
+	return ok
+}
+
+// MakeRaw puts the terminal into raw mode so input is read without
+// line buffering or echo. The terminal is restored when the command
+// completes or when Restore is called. Input which is not a terminal
+// is not modified.
+func (s *CommandStdin) MakeRaw() error {
+	fd, ok := s.terminal()
+	if !ok {
+		return nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.state != nil {
+		return nil
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	s.state = state
+
+	return nil
+}
+
+// Restore returns the terminal to the mode it was in before
+// MakeRaw was called
+func (s *CommandStdin) Restore() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.state == nil {
+		return nil
+	}
+
+	fd, _ := s.terminal()
+	err := term.Restore(fd, s.state)
+	s.state = nil
+
+	return err
+}
+
+// File descriptor of the input if it is a terminal
+func (s *CommandStdin) terminal() (int, bool) {
+	f, ok := s.r.(*os.File)
+	if !ok {
+		return 0, false
+	}
+	fd := int(f.Fd())
+
+	return fd, term.IsTerminal(fd)
+}
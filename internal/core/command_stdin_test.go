@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandStdin(t *testing.T) {
+	var echoed bytes.Buffer
+	echo := func(in *CommandStdin) int32 {
+		require.NoError(t, in.MakeRaw())
+		if _, err := io.Copy(&echoed, in); err != nil {
+			return 1
+		}
+		return 0
+	}
+
+	b := TestBasis(t, WithStdin(strings.NewReader("hello\n")))
+	stdin := newCommandStdin(b.stdin)
+	defer stdin.Restore()
+
+	result, err := b.callDynamicFunc(context.Background(), b.logger, echo, (*int32)(nil),
+		argmapper.Typed(stdin))
+	require.NoError(t, err)
+	require.Equal(t, int32(0), result)
+	require.Equal(t, "hello\n", echoed.String())
+	require.False(t, stdin.Interactive())
+	require.NoError(t, stdin.Restore())
+
+	// Without input attached, reads return EOF immediately
+	echoed.Reset()
+	result, err = b.callDynamicFunc(context.Background(), b.logger, echo, (*int32)(nil),
+		argmapper.Typed(newCommandStdin(nil)))
+	require.NoError(t, err)
+	require.Equal(t, int32(0), result)
+	require.Empty(t, echoed.String())
+}
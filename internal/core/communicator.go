@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+const WINRM_COMMUNICATOR_NAME = "winrm"
+
+// Default values used for WinRM connections
+const (
+	winrmDefaultPort      = 5985
+	winrmDefaultSSLPort   = 5986
+	winrmDefaultTransport = "negotiate"
+	winrmDefaultUsername  = "vagrant"
+	winrmDefaultPassword  = "vagrant"
+)
+
+// WinRMInfo contains the information required to
+// connect to a guest using WinRM
+type WinRMInfo struct {
+	Host      string // address of the guest
+	Port      int    // port WinRM is listening on
+	Transport string // transport used for connection (negotiate, ssl, plaintext)
+	Username  string // username for authentication
+	Password  string // password for authentication
+}
+
+// WinRMInfo returns the WinRM connection information for the
+// target using values from the winrm configuration namespace
+func (t *Target) WinRMInfo() (*WinRMInfo, error) {
+	info := &WinRMInfo{
+		Transport: winrmDefaultTransport,
+		Username:  winrmDefaultUsername,
+		Password:  winrmDefaultPassword,
+	}
+
+	var err error
+	if info.Host, err = t.winrmString("host", ""); err != nil {
+		return nil, err
+	}
+	if info.Host == "" {
+		return nil, fmt.Errorf("WinRM host is not configured for target %s", t.target.Name)
+	}
+	if info.Transport, err = t.winrmString("transport", info.Transport); err != nil {
+		return nil, err
+	}
+	if info.Username, err = t.winrmString("username", info.Username); err != nil {
+		return nil, err
+	}
+	if info.Password, err = t.winrmString("password", info.Password); err != nil {
+		return nil, err
+	}
+
+	info.Port = winrmDefaultPort
+	if info.Transport == "ssl" {
+		info.Port = winrmDefaultSSLPort
+	}
+	if raw, err := t.vagrantfile.GetValue("winrm", "port"); err == nil && raw != nil {
+		if info.Port, err = optionToInt(raw); err != nil {
+			return nil, fmt.Errorf("invalid WinRM port: %w", err)
+		}
+	}
+
+	return info, nil
+}
+
+// Fetch a string value from the winrm configuration
+// namespace, using the default if it is not set
+func (t *Target) winrmString(key, def string) (string, error) {
+	raw, err := t.vagrantfile.GetValue("winrm", key)
+	if err != nil || raw == nil {
+		return def, nil
+	}
+
+	v, err := optionToString(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid WinRM %s: %w", key, err)
+	}
+
+	return v, nil
+}
+
+// Determine the name of the communicator to use for the
+// target. The configured communicator is used if set.
+// Otherwise WinRM is used for Windows guests and SSH is
+// used for all others.
+func (t *Target) communicatorName() (name string, configured bool, err error) {
+	raw, err := t.vagrantfile.GetValue("vm", "communicator")
+	if err == nil && raw != nil {
+		if name, err = optionToString(raw); err != nil {
+			return "", false, err
+		}
+
+		return name, true, nil
+	}
+
+	if raw, err := t.vagrantfile.GetValue("vm", "guest"); err == nil && raw != nil {
+		if guest, err := optionToString(raw); err == nil && guest == "windows" {
+			t.logger.Debug("windows guest detected, using winrm communicator")
+
+			return WINRM_COMMUNICATOR_NAME, false, nil
+		}
+	}
+
+	return DEFAULT_COMMUNICATOR_NAME, false, nil
+}
+
+// Provide a clear error when a communicator plugin cannot be loaded
+func (t *Target) communicatorError(name string, configured bool, err error) error {
+	if _, gerr := t.project.basis.plugins.Get(name, component.CommunicatorType); gerr == nil {
+		return err
+	}
+
+	if !configured {
+		return fmt.Errorf("%w: default communicator %q is not installed",
+			ErrNoCommunicator, name)
+	}
+
+	return fmt.Errorf("%w: %q", ErrCommunicatorNotInstalled, name)
+}
+
+// Convert the option value into an integer
+func optionToInt(
+	opt interface{}, // value to convert
+) (int, error) {
+	switch v := opt.(type) {
+	case int:
+		return v, nil
+	case int32:
+		return int(v), nil
+	case int64:
+		return int(v), nil
+	case float64:
+		return int(v), nil
+	case string:
+		return strconv.Atoi(v)
+	default:
+		return 0, fmt.Errorf("option value is not integer type (%T)", opt)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+)
+
+// Shells supported for completion scripts
+var completionShells = []string{"bash", "fish", "zsh"}
+
+// CompletionScript generates a shell completion script for all
+// commands, subcommands and flags. The command information is
+// gathered using RunInit so completions match available commands.
+func (b *Basis) CompletionScript(shell string) (string, error) {
+	gen, ok := map[string]func(*completionTree) string{
+		"bash": bashCompletion,
+		"fish": fishCompletion,
+		"zsh":  zshCompletion,
+	}[shell]
+	if !ok {
+		return "", fmt.Errorf("unsupported shell %q, supported shells: %s",
+			shell, strings.Join(completionShells, ", "))
+	}
+
+	result, err := b.RunInit()
+	if err != nil {
+		return "", err
+	}
+
+	return gen(newCompletionTree(result.Commands)), nil
+}
+
+// completionTree contains the completion information for
+// each command path. The root command path is empty.
+type completionTree struct {
+	paths    []string                                      // sorted command paths
+	children map[string][]string                           // subcommands for each path
+	synopsis map[string]string                             // synopsis for each path
+	flags    map[string][]*vagrant_plugin_sdk.Command_Flag // flags for each path
+}
+
+func newCompletionTree(cmds []*vagrant_plugin_sdk.Command_CommandInfo) *completionTree {
+	t := &completionTree{
+		children: map[string][]string{"": {}},
+		synopsis: map[string]string{},
+		flags:    map[string][]*vagrant_plugin_sdk.Command_Flag{},
+	}
+
+	for _, c := range cmds {
+		parts := strings.Fields(c.Name)
+		for i := range parts {
+			parent := strings.Join(parts[:i], " ")
+			path := strings.Join(parts[:i+1], " ")
+			if _, ok := t.children[path]; !ok {
+				t.children[path] = []string{}
+				t.children[parent] = append(t.children[parent], parts[i])
+			}
+		}
+		path := strings.Join(parts, " ")
+		t.synopsis[path] = c.Synopsis
+		t.flags[path] = append(t.flags[path], c.Flags...)
+	}
+
+	for path, children := range t.children {
+		sort.Strings(children)
+		t.paths = append(t.paths, path)
+	}
+	sort.Strings(t.paths)
+
+	return t
+}
+
+// Words which can be completed for the given path
+func (t *completionTree) words(path string) []string {
+	words := append([]string{}, t.children[path]...)
+	for _, f := range t.flags[path] {
+		words = append(words, "--"+f.LongName)
+		if f.ShortName != "" {
+			words = append(words, "-"+f.ShortName)
+		}
+		for _, a := range f.Aliases {
+			words = append(words, "--"+a)
+		}
+	}
+
+	return words
+}
+
+func bashCompletion(t *completionTree) string {
+	var b strings.Builder
+	b.WriteString(`_vagrant() {
+  local cur path words i
+  cur="${COMP_WORDS[COMP_CWORD]}"
+  path=""
+  for ((i=1; i<COMP_CWORD; i++)); do
+    case "${COMP_WORDS[i]}" in
+      -*) ;;
+      *) path="${path:+$path }${COMP_WORDS[i]}" ;;
+    esac
+  done
+  case "$path" in
+`)
+	for _, path := range t.paths {
+		fmt.Fprintf(&b, "    %q) words=%q ;;\n", path, strings.Join(t.words(path), " "))
+	}
+	b.WriteString(`    *) words="" ;;
+  esac
+  COMPREPLY=($(compgen -W "$words" -- "$cur"))
+}
+complete -F _vagrant vagrant
+`)
+
+	return b.String()
+}
+
+func zshCompletion(t *completionTree) string {
+	return "autoload -U +X bashcompinit && bashcompinit\n" + bashCompletion(t)
+}
+
+func fishCompletion(t *completionTree) string {
+	var b strings.Builder
+	b.WriteString(`function __vagrant_path
+    set -l tokens (commandline -opc)
+    set -e tokens[1]
+    set -l path
+    for t in $tokens
+        if not string match -q -- '-*' $t
+            set path $path $t
+        end
+    end
+    echo (string join ' ' $path)
+end
+complete -c vagrant -f
+`)
+	for _, path := range t.paths {
+		cond := fmt.Sprintf("test \"(__vagrant_path)\" = %s", fishQuote(path))
+		for _, child := range t.children[path] {
+			sub := strings.TrimSpace(path + " " + child)
+			fmt.Fprintf(&b, "complete -c vagrant -n %s -a %s -d %s\n",
+				fishQuote(cond), fishQuote(child), fishQuote(t.synopsis[sub]))
+		}
+		for _, f := range t.flags[path] {
+			fmt.Fprintf(&b, "complete -c vagrant -n %s -l %s", fishQuote(cond), fishQuote(f.LongName))
+			if f.ShortName != "" {
+				fmt.Fprintf(&b, " -s %s", fishQuote(f.ShortName))
+			}
+			for _, a := range f.Aliases {
+				fmt.Fprintf(&b, " -l %s", fishQuote(a))
+			}
+			fmt.Fprintf(&b, " -d %s\n", fishQuote(f.Description))
+		}
+	}
+
+	return b.String()
+}
+
+// Quote a value for use within a fish script
+func fishQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return "'" + s + "'"
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func testCompletionCommands() []*vagrant_plugin_sdk.Command_CommandInfo {
+	return []*vagrant_plugin_sdk.Command_CommandInfo{
+		{
+			Name:     "box",
+			Synopsis: "manages boxes",
+		},
+		{
+			Name:     "box add",
+			Synopsis: "adds a box",
+			Flags: []*vagrant_plugin_sdk.Command_Flag{
+				{LongName: "force", ShortName: "f", Aliases: []string{"overwrite"}},
+			},
+		},
+		{
+			Name:     "up",
+			Synopsis: "starts the guest",
+			Flags: []*vagrant_plugin_sdk.Command_Flag{
+				{LongName: "provision", Description: "don't skip"},
+			},
+		},
+	}
+}
+
+func TestCompletionTree(t *testing.T) {
+	tree := newCompletionTree(testCompletionCommands())
+
+	require.Equal(t, []string{"", "box", "box add", "up"}, tree.paths)
+	require.Equal(t, []string{"box", "up"}, tree.words(""))
+	require.Equal(t, []string{"add"}, tree.words("box"))
+	require.Equal(t, []string{"--force", "-f", "--overwrite"}, tree.words("box add"))
+	require.Equal(t, []string{"--provision"}, tree.words("up"))
+}
+
+func TestCompletionScripts(t *testing.T) {
+	tree := newCompletionTree(testCompletionCommands())
+
+	bash := bashCompletion(tree)
+	require.Contains(t, bash, `"box add") words="--force -f --overwrite" ;;`)
+	require.Contains(t, bash, "complete -F _vagrant vagrant")
+
+	zsh := zshCompletion(tree)
+	require.Contains(t, zsh, "bashcompinit")
+	require.Contains(t, zsh, `"") words="box up" ;;`)
+
+	fish := fishCompletion(tree)
+	require.Contains(t, fish, `-a 'add' -d 'adds a box'`)
+	require.Contains(t, fish, `-l 'force' -s 'f' -l 'overwrite'`)
+	require.Contains(t, fish, `-d 'don\'t skip'`)
+}
+
+func TestBasisCompletionScriptUnsupported(t *testing.T) {
+	b := TestBasis(t)
+
+	_, err := b.CompletionScript("powershell")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "bash, fish, zsh")
+}
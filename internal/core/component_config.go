@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// Apply the configuration defined for the component, if any. The
+// configuration is read from the basis configuration within a block
+// labeled with the component type and the plugin name:
+//
+//	component "provider" "virtualbox" {
+//	  gui = true
+//	}
+//
+// Components accept configuration by implementing the SDK
+// component.Configurable interface, which the plugin clients
+// forward to the plugin. The block is decoded into the value
+// returned from Config and, for component.ConfigurableNotify,
+// passed to ConfigSet. Defining configuration for a component
+// which is not configurable is an error.
+func (b *Basis) configureComponent(
+	c *Component, // component to configure
+	typ component.Type, // type of component
+	name string, // name of the plugin
+) error {
+	cfg, err := b.Config()
+	if err != nil || cfg == nil {
+		return err
+	}
+	comp := cfg.Component(typ.String(), name)
+	if comp == nil {
+		return nil
+	}
+
+	b.logger.Debug("configuring component",
+		"type", typ.String(),
+		"name", name,
+	)
+
+	if diags := component.Configure(c.Value, comp.Body, comp.EvalContext()); diags.HasErrors() {
+		return fmt.Errorf("invalid configuration for %s %s: %w", typ.String(), name, diags)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+type testProviderConfig struct {
+	GUI    bool `hcl:"gui,optional"`
+	Memory int  `hcl:"memory"`
+}
+
+type testConfigurableComponent struct {
+	plugin.TestPluginWithFakeBroker
+
+	config *testProviderConfig
+}
+
+func (c *testConfigurableComponent) Config() (interface{}, error) {
+	return &testProviderConfig{}, nil
+}
+
+func (c *testConfigurableComponent) ConfigSet(v interface{}) error {
+	c.config = v.(*testProviderConfig)
+	return nil
+}
+
+func TestBasisComponentConfig(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, basisConfigFilename), []byte(`
+component "provider" "configurable" {
+  gui    = true
+  memory = 2048
+}
+
+component "provider" "invalid" {
+  gui = true
+}
+
+component "provider" "plain" {
+  gui = true
+}
+`), 0644))
+
+	configurable := &testConfigurableComponent{}
+	invalid := &testConfigurableComponent{}
+	unconfigured := &testConfigurableComponent{}
+	manager := plugin.TestManager(t,
+		plugin.TestPlugin(t, configurable,
+			plugin.WithPluginName("configurable"),
+			plugin.WithPluginTypes(component.ProviderType),
+		),
+		plugin.TestPlugin(t, invalid,
+			plugin.WithPluginName("invalid"),
+			plugin.WithPluginTypes(component.ProviderType),
+		),
+		plugin.TestPlugin(t, unconfigured,
+			plugin.WithPluginName("unconfigured"),
+			plugin.WithPluginTypes(component.ProviderType),
+		),
+		plugin.TestPlugin(t, &plugin.TestPluginWithFakeBroker{},
+			plugin.WithPluginName("plain"),
+			plugin.WithPluginTypes(component.ProviderType),
+		),
+	)
+	b := TestBasis(t, WithBasisPath(root), WithPluginManager(manager))
+
+	// Configuration matching the schema is provided on load
+	_, err := b.component(b.ctx, component.ProviderType, "configurable")
+	require.NoError(t, err)
+	require.Equal(t, &testProviderConfig{GUI: true, Memory: 2048}, configurable.config)
+
+	// Configuration not matching the schema fails the load
+	_, err = b.component(b.ctx, component.ProviderType, "invalid")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid configuration for Provider invalid")
+
+	// Configuration for a component which is not configurable fails the load
+	_, err = b.component(b.ctx, component.ProviderType, "plain")
+	require.Error(t, err)
+
+	// Components without configuration are not configured
+	_, err = b.component(b.ctx, component.ProviderType, "unconfigured")
+	require.NoError(t, err)
+	require.Nil(t, unconfigured.config)
+
+	// Configuration values are available from the environment
+	env, err := b.Environment()
+	require.NoError(t, err)
+	values, err := env.ComponentConfig(component.ProviderType, "configurable")
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"gui": true, "memory": float64(2048)}, values)
+	values, err = env.ComponentConfig(component.ProviderType, "unconfigured")
+	require.NoError(t, err)
+	require.Nil(t, values)
+}
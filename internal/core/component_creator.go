@@ -25,8 +25,11 @@ type Component struct {
 
 	// These are private, please do not access them ever except as an
 	// internal Component implementation detail.
-	closed bool
-	plugin *plugin.Instance
+	beforeClose func()
+	closed      bool
+	onClose     func()
+	plugin      *plugin.Instance
+	release     func()
 }
 
 // Close cleans up any resources associated with the Component. Close should
@@ -42,9 +45,27 @@ func (c *Component) Close() error {
 	}
 
 	c.closed = true
+	c.Release()
+	if c.beforeClose != nil {
+		c.beforeClose()
+	}
 	if c.plugin != nil {
 		c.plugin.Close()
 	}
+	if c.onClose != nil {
+		c.onClose()
+	}
 
 	return nil
 }
+
+// Release marks the component as no longer in use without closing
+// it. Cached plugin instances are only reaped once every component
+// using them has been released. Close also releases the component.
+func (c *Component) Release() {
+	if c == nil || c.release == nil {
+		return
+	}
+
+	c.release()
+}
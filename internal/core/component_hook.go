@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+// ComponentHook observes every component created by the basis.
+// It can be used to decorate components, pool them, or detect
+// components which are never closed.
+type ComponentHook interface {
+	// ComponentCreated is called after the component has been
+	// created and before it is returned. The value returned is
+	// used as the component's Value, allowing it to be wrapped.
+	// If an error is returned the component is closed and the
+	// error is returned to the caller.
+	ComponentCreated(c *Component) (interface{}, error)
+
+	// ComponentClosing is called before the component is closed
+	ComponentClosing(c *Component)
+}
+
+// Apply the component hook, if set, to a newly created component
+func (b *Basis) hookComponent(c *Component) (*Component, error) {
+	if b.componentHook == nil {
+		return c, nil
+	}
+
+	c.beforeClose = func() {
+		b.componentHook.ComponentClosing(c)
+	}
+
+	v, err := b.componentHook.ComponentCreated(c)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	c.Value = v
+
+	return c, nil
+}
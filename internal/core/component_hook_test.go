@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+type testComponentHook struct {
+	created []string
+	closing []string
+	err     error
+}
+
+type wrappedComponent struct {
+	value interface{}
+}
+
+func (h *testComponentHook) ComponentCreated(c *Component) (interface{}, error) {
+	h.created = append(h.created, c.Info.Name)
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &wrappedComponent{value: c.Value}, nil
+}
+
+func (h *testComponentHook) ComponentClosing(c *Component) {
+	h.closing = append(h.closing, c.Info.Name)
+}
+
+func TestBasisComponentHook(t *testing.T) {
+	guest := BuildTestGuestPlugin("myguest", "")
+	guest.On("Close").Return(nil)
+	myguest := plugin.TestPlugin(t,
+		guest,
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	hook := &testComponentHook{}
+	b := TestBasis(t,
+		WithPluginManager(plugin.TestManager(t, myguest)),
+		WithComponentHook(hook),
+	)
+
+	c, err := b.component(b.ctx, component.GuestType, "myguest")
+	require.NoError(t, err)
+	require.IsType(t, &wrappedComponent{}, c.Value)
+	require.Equal(t, []string{"myguest"}, hook.created)
+	require.Empty(t, hook.closing)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, c.Close())
+	require.Equal(t, []string{"myguest"}, hook.closing)
+
+	hook.err = errors.New("rejected")
+	_, err = b.component(b.ctx, component.GuestType, "myguest")
+	require.ErrorIs(t, err, hook.err)
+	require.Equal(t, []string{"myguest", "myguest"}, hook.closing)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// ResolveComponent loads the component with the given name when
+// its type is not known. All component types are searched and the
+// type of the component is returned along with the component. An
+// error is returned if no component has the name, or if components
+// of more than one type have the name.
+func (b *Basis) ResolveComponent(
+	ctx context.Context, // context for the plugin
+	name string, // name of the component
+) (*Component, component.Type, error) {
+	types := []component.Type{}
+	for typ := range component.TypeMap {
+		names, err := b.plugins.Typed(typ)
+		if err != nil {
+			return nil, component.InvalidType, err
+		}
+		for _, n := range names {
+			if n == name {
+				types = append(types, typ)
+				break
+			}
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	switch len(types) {
+	case 0:
+		return nil, component.InvalidType, fmt.Errorf("%w: %s", ErrComponentNotFound, name)
+	case 1:
+	default:
+		typeNames := make([]string, len(types))
+		for i, typ := range types {
+			typeNames[i] = typ.String()
+		}
+
+		return nil, component.InvalidType, fmt.Errorf("%w: %s is provided as %s",
+			ErrComponentAmbiguous, name, strings.Join(typeNames, ", "))
+	}
+
+	c, err := b.component(ctx, types[0], name)
+	if err != nil {
+		return nil, component.InvalidType, err
+	}
+
+	return c, types[0], nil
+}
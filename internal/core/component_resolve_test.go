@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+func TestBasisResolveComponent(t *testing.T) {
+	myguest := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("myguest", ""),
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	myhost := plugin.TestPlugin(t,
+		BuildTestHostPlugin("shared", ""),
+		plugin.WithPluginName("shared"),
+		plugin.WithPluginTypes(component.HostType),
+	)
+	sharedGuest := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("shared", ""),
+		plugin.WithPluginName("shared"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	b := TestBasis(t, WithPluginManager(
+		plugin.TestManager(t, myguest, myhost, sharedGuest)))
+
+	c, typ, err := b.ResolveComponent(b.ctx, "myguest")
+	require.NoError(t, err)
+	require.Equal(t, component.GuestType, typ)
+	require.Equal(t, "myguest", c.Info.Name)
+
+	_, _, err = b.ResolveComponent(b.ctx, "shared")
+	require.ErrorIs(t, err, ErrComponentAmbiguous)
+	require.Contains(t, err.Error(), "shared is provided as Guest, Host")
+
+	_, _, err = b.ResolveComponent(b.ctx, "missing")
+	require.ErrorIs(t, err, ErrComponentNotFound)
+}
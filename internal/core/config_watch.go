@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/vagrant/internal/config"
+)
+
+// Interval between checks of the configuration file for changes
+var configWatchInterval = 2 * time.Second
+
+// State of the configuration file used to detect changes
+type configFileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+// Current state of the configuration file
+func statConfigFile(path string) configFileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return configFileState{}
+	}
+
+	return configFileState{
+		exists:  true,
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+}
+
+// Start watching the configuration file for changes. The file
+// is checked on an interval and the configuration is reloaded
+// when it changes. Watching stops when the basis is closed.
+func (b *Basis) watchConfig() {
+	if b.configPath == "" {
+		b.logger.Warn("configuration watch requested but no configuration file is used")
+		return
+	}
+
+	done := make(chan struct{})
+	b.Closer(func() error {
+		close(done)
+		return nil
+	})
+
+	go func() {
+		ticker := time.NewTicker(configWatchInterval)
+		defer ticker.Stop()
+
+		last := statConfigFile(b.configPath)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			current := statConfigFile(b.configPath)
+			if current == last {
+				continue
+			}
+			last = current
+
+			if err := b.reloadConfig(); err != nil {
+				b.logger.Error("failed to reload configuration, previous configuration retained",
+					"path", b.configPath,
+					"error", err,
+				)
+			}
+		}
+	}()
+}
+
+// Load the configuration file and replace the effective
+// configuration. If the new configuration cannot be loaded
+// or is not valid, the current configuration is kept. An
+// EventConfigReloaded event is emitted with the result.
+func (b *Basis) reloadConfig() (err error) {
+	defer func() {
+		b.events.emit(EventConfigReloaded, b.configPath, "", err)
+	}()
+
+	var pathConfig *config.Config
+	if b.configLoader != nil {
+		pathConfig, err = b.configLoader()
+	} else if _, err = os.Stat(b.configPath); err == nil {
+		pathConfig, err = config.Load(b.configPath, filepath.Dir(b.configPath))
+	} else if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	if err != nil {
+		return
+	}
+
+	cfg, err := b.mergeConfig(pathConfig)
+	if err != nil {
+		return
+	}
+
+	b.configM.Lock()
+	defer b.configM.Unlock()
+	b.config = cfg
+	b.cache.Delete(environmentCacheKey)
+	b.logger.Info("configuration reloaded", "path", b.configPath)
+
+	return
+}
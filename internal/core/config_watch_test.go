@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisConfigWatch(t *testing.T) {
+	interval := configWatchInterval
+	configWatchInterval = 10 * time.Millisecond
+	defer func() { configWatchInterval = interval }()
+
+	root := t.TempDir()
+	path := filepath.Join(root, basisConfigFilename)
+	require.NoError(t, os.WriteFile(path, []byte(`labels = { team = "ops" }`), 0644))
+
+	b := TestBasis(t, WithBasisPath(root), WithConfigWatch())
+	events, unsubscribe := b.SubscribeEvents(0)
+	defer unsubscribe()
+	label := func() string {
+		c, err := b.Config()
+		require.NoError(t, err)
+		return c.Labels["team"]
+	}
+	require.Equal(t, "ops", label())
+	env, err := b.Environment()
+	require.NoError(t, err)
+
+	reloaded := func() *LifecycleEvent {
+		for {
+			select {
+			case e := <-events:
+				if e.Type == EventConfigReloaded {
+					return e
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("configuration was not reloaded")
+			}
+		}
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte(`labels = { team = "platform" }`), 0644))
+	require.NoError(t, reloaded().Error)
+	require.Equal(t, "platform", label())
+
+	// Resolved environment reflects the new configuration
+	env, err = b.Environment()
+	require.NoError(t, err)
+	team, _ := env.Label("team")
+	require.Equal(t, "platform", team)
+
+	// Invalid configuration keeps the current configuration
+	require.NoError(t, os.WriteFile(path, []byte(`labels = {`), 0644))
+	require.Error(t, reloaded().Error)
+	require.Equal(t, "platform", label())
+
+	// No reloads happen once closed
+	require.NoError(t, b.Close())
+	require.NoError(t, os.WriteFile(path, []byte(`labels = { team = "closed" }`), 0644))
+	time.Sleep(5 * configWatchInterval)
+	require.Equal(t, "platform", label())
+}
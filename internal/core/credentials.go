@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+)
+
+// CredentialProvider supplies secrets, like cloud API keys, to
+// plugins. Plugins request credentials by name through the
+// Credentials value provided to their functions instead of reading
+// the environment directly. See Credentials for which plugins can
+// receive them.
+//
+// The provider is implemented by the embedder so secrets can be
+// sourced from any backend, such as environment variables, files,
+// or Vault. Secrets are fetched when a plugin requests them and are
+// never cached by the basis, added to trace spans, or logged.
+type CredentialProvider interface {
+	// Credential returns the secret for the given name. If no
+	// secret exists for the name, ErrCredentialNotFound should
+	// be returned.
+	Credential(ctx context.Context, name string) (Secret, error)
+}
+
+// CredentialProviderFunc allows a function to be used as a
+// CredentialProvider
+type CredentialProviderFunc func(ctx context.Context, name string) (Secret, error)
+
+// Credential implements CredentialProvider
+func (f CredentialProviderFunc) Credential(ctx context.Context, name string) (Secret, error) {
+	return f(ctx, name)
+}
+
+// CredentialStore is a backend holding named secrets, such as
+// environment variables, files, or Vault. It is implemented by the
+// embedder and set on the basis using WithCredentialStore. Secrets
+// are fetched from the store when a plugin requests them.
+type CredentialStore interface {
+	// Get returns the secret stored with the given name. If no
+	// secret exists for the name, ErrCredentialNotFound should
+	// be returned.
+	Get(ctx context.Context, name string) (Secret, error)
+}
+
+// Provides credentials from a credential store
+type storeCredentialProvider struct {
+	store CredentialStore
+}
+
+// Credential implements CredentialProvider
+func (p *storeCredentialProvider) Credential(ctx context.Context, name string) (Secret, error) {
+	return p.store.Get(ctx, name)
+}
+
+// Secret is a credential value. When formatted for output the
+// value is redacted so it is never included in logs or UI output.
+// Convert to a string to access the actual value.
+type Secret = commandargs.Secret
+
+// Credentials is provided to plugin functions when the basis has
+// a credential provider. It is the only channel credentials are
+// passed through, and it never exposes secrets when formatted.
+//
+// Plugins running in their own process request
+// commandargs.Credentials. Core serves the credentials over the
+// plugin broker and only sends a secret when the plugin requests
+// it by name.
+type Credentials struct {
+	ctx      context.Context
+	provider CredentialProvider
+}
+
+// Get returns the secret for the given name
+func (c *Credentials) Get(name string) (Secret, error) {
+	s, err := c.provider.Credential(c.ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get credential %q: %w", name, err)
+	}
+
+	return s, nil
+}
+
+// String implements fmt.Stringer
+func (c *Credentials) String() string {
+	return "Credentials(" + commandargs.Redacted + ")"
+}
+
+// GoString implements fmt.GoStringer
+func (c *Credentials) GoString() string {
+	return c.String()
+}
+
+// Returns the credentials for plugin functions, or nil if no
+// credential provider is set
+func (b *Basis) credentials() *Credentials {
+	if b.credProvider == nil {
+		return nil
+	}
+
+	return &Credentials{ctx: b.ctx, provider: b.credProvider}
+}
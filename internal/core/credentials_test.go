@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+)
+
+func TestBasisCredentials(t *testing.T) {
+	provider := CredentialProviderFunc(func(_ context.Context, name string) (Secret, error) {
+		if name == "api_key" {
+			return "s3cr3t", nil
+		}
+		return "", ErrCredentialNotFound
+	})
+	b := TestBasis(t, WithCredentialProvider(provider))
+
+	var buf bytes.Buffer
+	log := hclog.New(&hclog.LoggerOptions{Output: &buf, Level: hclog.Trace})
+	fn := func(c *Credentials) (int32, error) {
+		s, err := c.Get("api_key")
+		if err != nil {
+			return 0, err
+		}
+		log.Trace("credentials", "creds", c, "key", s)
+		log.Trace(fmt.Sprintf("%v %#v %s", c, s, s))
+		if string(s) != "s3cr3t" {
+			return 1, nil
+		}
+
+		_, err = c.Get("missing")
+		require.ErrorIs(t, err, ErrCredentialNotFound)
+		return 0, nil
+	}
+
+	result, err := b.callDynamicFunc(context.Background(), b.logger, fn, (*int32)(nil))
+	require.NoError(t, err)
+	require.Equal(t, int32(0), result)
+	require.Contains(t, buf.String(), commandargs.Redacted)
+	require.NotContains(t, buf.String(), "s3cr3t")
+
+	// Credentials are not available without a provider
+	b = TestBasis(t)
+	_, err = b.callDynamicFunc(context.Background(), b.logger, fn, (*int32)(nil))
+	require.Error(t, err)
+}
+
+// testCredentialStore is a credential store backed by a map
+type testCredentialStore map[string]Secret
+
+func (s testCredentialStore) Get(_ context.Context, name string) (Secret, error) {
+	v, ok := s[name]
+	if !ok {
+		return "", ErrCredentialNotFound
+	}
+	return v, nil
+}
+
+func TestBasisCredentialStore(t *testing.T) {
+	b := TestBasis(t, WithCredentialStore(testCredentialStore{"api_key": "s3cr3t"}))
+
+	var secret Secret
+	fn := func(c *Credentials) (int32, error) {
+		var err error
+		if secret, err = c.Get("api_key"); err != nil {
+			return 0, err
+		}
+
+		_, err = c.Get("missing")
+		require.ErrorIs(t, err, ErrCredentialNotFound)
+		return 0, nil
+	}
+
+	_, err := b.callDynamicFunc(context.Background(), b.logger, fn, (*int32)(nil))
+	require.NoError(t, err)
+	require.Equal(t, "s3cr3t", string(secret))
+	require.Equal(t, commandargs.Redacted, fmt.Sprintf("%v", secret))
+
+	// The store is required
+	_, err = NewBasis(context.Background(), WithCredentialStore(nil))
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Name of the file within the basis data directory
+// which stores the data directory layout version
+const dataDirVersionFile = "version"
+
+// dataDirMigration upgrades the data directory layout to
+// a specific version. Migrations must be idempotent as an
+// interrupted migration will be run again.
+type dataDirMigration struct {
+	version     int                // version the layout is upgraded to
+	description string             // description of the changes
+	migrate     func(*Basis) error // applies the changes
+}
+
+// Migrations applied to the data directory, ordered by version.
+// The last entry defines the current layout version.
+var dataDirMigrations = []*dataDirMigration{
+	{
+		version:     1,
+		description: "add data directory version marker",
+		migrate:     func(*Basis) error { return nil },
+	},
+}
+
+// Current data directory layout version
+func dataDirCurrentVersion() int {
+	if len(dataDirMigrations) == 0 {
+		return 0
+	}
+
+	return dataDirMigrations[len(dataDirMigrations)-1].version
+}
+
+// Upgrade the data directory layout to the current version. The
+// version marker is updated after each migration completes so an
+// interrupted upgrade resumes with the incomplete migration.
+func (b *Basis) migrateDataDir() error {
+	version, err := b.dataDirVersion()
+	if err != nil {
+		return err
+	}
+
+	current := dataDirCurrentVersion()
+	if version > current {
+		return fmt.Errorf("data directory version %d is newer than supported version %d, "+
+			"a newer version of Vagrant is required", version, current)
+	}
+
+	for _, m := range dataDirMigrations {
+		if m.version <= version {
+			continue
+		}
+
+		b.logger.Info("migrating data directory",
+			"from", version,
+			"to", m.version,
+			"description", m.description,
+		)
+
+		if err = m.migrate(b); err != nil {
+			return fmt.Errorf("failed to migrate data directory to version %d: %w",
+				m.version, err)
+		}
+		if err = b.setDataDirVersion(m.version); err != nil {
+			return err
+		}
+		version = m.version
+	}
+
+	return nil
+}
+
+// Read the data directory layout version. A missing version
+// marker is version zero.
+func (b *Basis) dataDirVersion() (int, error) {
+	path := b.dir.DataDir().Join(dataDirVersionFile).String()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	version, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid data directory version in %s: %w", path, err)
+	}
+
+	return version, nil
+}
+
+// Write the data directory layout version. The marker is written
+// to a temporary file and renamed so it is never left partially
+// written.
+func (b *Basis) setDataDirVersion(version int) error {
+	path := b.dir.DataDir().Join(dataDirVersionFile).String()
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.Itoa(version)), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
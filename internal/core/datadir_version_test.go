@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisDataDirVersion(t *testing.T) {
+	b := TestBasis(t)
+
+	// Initializing the basis sets the current version
+	version, err := b.dataDirVersion()
+	require.NoError(t, err)
+	require.Equal(t, dataDirCurrentVersion(), version)
+
+	// Newer layouts are rejected
+	require.NoError(t, b.setDataDirVersion(dataDirCurrentVersion()+1))
+	err = b.migrateDataDir()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "newer than supported")
+
+	// Invalid markers are rejected
+	path := b.dir.DataDir().Join(dataDirVersionFile).String()
+	require.NoError(t, os.WriteFile(path, []byte("unknown"), 0644))
+	_, err = b.dataDirVersion()
+	require.Error(t, err)
+}
+
+func TestBasisDataDirMigrations(t *testing.T) {
+	b := TestBasis(t)
+
+	original := dataDirMigrations
+	defer func() { dataDirMigrations = original }()
+
+	applied := []int{}
+	fail := true
+	dataDirMigrations = []*dataDirMigration{
+		original[0],
+		{
+			version: 2,
+			migrate: func(*Basis) error {
+				applied = append(applied, 2)
+				return nil
+			},
+		},
+		{
+			version: 3,
+			migrate: func(*Basis) error {
+				applied = append(applied, 3)
+				if fail {
+					return errors.New("interrupted")
+				}
+				return nil
+			},
+		},
+	}
+
+	// An interrupted migration leaves the last completed version
+	require.Error(t, b.migrateDataDir())
+	version, err := b.dataDirVersion()
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+
+	// Running again resumes with the incomplete migration
+	fail = false
+	require.NoError(t, b.migrateDataDir())
+	require.Equal(t, []int{2, 3, 3}, applied)
+
+	// Nothing is applied when up to date
+	require.NoError(t, b.migrateDataDir())
+	require.Len(t, applied, 3)
+
+	content, err := os.ReadFile(b.dir.DataDir().Join(dataDirVersionFile).String())
+	require.NoError(t, err)
+	require.Equal(t, strconv.Itoa(3), string(content))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/core"
+)
+
+// Environment variable requesting the default provider
+const defaultProviderEnv = "VAGRANT_DEFAULT_PROVIDER"
+
+// Provider requested as the default and the source which requested
+// it. The WithDefaultProvider option takes precedence, followed by
+// the VAGRANT_DEFAULT_PROVIDER environment variable, and then the
+// default_provider configuration value. If no provider has been
+// requested the name is empty.
+func (b *Basis) requestedProvider() (name, source string) {
+	if b.defaultProv != "" {
+		return b.defaultProv, "option"
+	}
+	if v := os.Getenv(defaultProviderEnv); v != "" {
+		return v, defaultProviderEnv
+	}
+	if cfg, err := b.Config(); err == nil && cfg != nil && cfg.DefaultProvider != "" {
+		return cfg.DefaultProvider, "configuration"
+	}
+
+	return "", ""
+}
+
+// Check that the provider is installed and, if requested, usable.
+// A provider which is not installed returns ErrProviderNotInstalled
+// and one which is not usable returns ErrProviderNotUsable.
+func (b *Basis) checkProvider(name string, usable bool) error {
+	names, err := b.plugins.Typed(component.ProviderType)
+	if err != nil {
+		return err
+	}
+	installed := false
+	for _, n := range names {
+		if n == name {
+			installed = true
+			break
+		}
+	}
+	if !installed {
+		return fmt.Errorf("%w: %s", ErrProviderNotInstalled, name)
+	}
+	if !usable {
+		return nil
+	}
+
+	plug, err := b.plugins.GetPlugin(name, component.ProviderType.String())
+	if err != nil {
+		return err
+	}
+	ok, err := plug.Plugin.(core.Provider).Usable()
+	if err != nil {
+		return fmt.Errorf("failed to check if provider %s is usable: %w", name, err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrProviderNotUsable, name)
+	}
+
+	return nil
+}
+
+// Log the provider selected as the default and the reason
+// it was selected
+func logDefaultProvider(logger hclog.Logger, name, reason string) {
+	logger.Info("selected default provider",
+		"provider", name,
+		"reason", reason,
+	)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/core"
+	coremocks "github.com/hashicorp/vagrant-plugin-sdk/core/mocks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/config"
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+type testProviderPlugin struct {
+	plugin.TestPluginWithFakeBroker
+	coremocks.Provider
+}
+
+func testProvider(t *testing.T, name string, usable bool, priority int) *plugin.Plugin {
+	p := &testProviderPlugin{}
+	p.On("Usable").Return(usable, nil)
+
+	return plugin.TestPlugin(t, p,
+		plugin.WithPluginName(name),
+		plugin.WithPluginTypes(component.ProviderType),
+		plugin.WithPluginOptions(map[component.Type]interface{}{
+			component.ProviderType: &component.ProviderOptions{
+				Defaultable: true,
+				Priority:    priority,
+			},
+		}),
+	)
+}
+
+func TestBasisDefaultProvider(t *testing.T) {
+	t.Setenv("VAGRANT_DEFAULT_PROVIDER", "")
+	t.Setenv("VAGRANT_PREFERRED_PROVIDERS", "")
+	newBasis := func(opts ...BasisOption) *Basis {
+		manager := plugin.TestManager(t,
+			testProvider(t, "virtualbox", true, 5),
+			testProvider(t, "docker", true, 10),
+			testProvider(t, "hyperv", false, 20),
+		)
+		return TestBasis(t, append([]BasisOption{WithPluginManager(manager)}, opts...)...)
+	}
+
+	// Usable provider with the highest priority is used by default
+	name, err := newBasis().DefaultProvider()
+	require.NoError(t, err)
+	require.Equal(t, "docker", name)
+
+	// Configuration is used when set
+	cfg := &config.Config{DefaultProvider: "virtualbox"}
+	name, err = newBasis(WithConfig(cfg)).DefaultProvider()
+	require.NoError(t, err)
+	require.Equal(t, "virtualbox", name)
+
+	// Environment variable takes precedence over configuration
+	t.Setenv("VAGRANT_DEFAULT_PROVIDER", "docker")
+	name, err = newBasis(WithConfig(cfg)).DefaultProvider()
+	require.NoError(t, err)
+	require.Equal(t, "docker", name)
+
+	// Option takes precedence over the environment variable
+	name, err = newBasis(WithDefaultProvider("virtualbox")).DefaultProvider()
+	require.NoError(t, err)
+	require.Equal(t, "virtualbox", name)
+
+	// Requested providers must be installed and usable
+	_, err = newBasis(WithDefaultProvider("vmware")).DefaultProvider()
+	require.ErrorIs(t, err, ErrProviderNotInstalled)
+	_, err = newBasis(WithDefaultProvider("hyperv")).DefaultProvider()
+	require.ErrorIs(t, err, ErrProviderNotUsable)
+
+	_, err = NewBasis(context.Background(), WithDefaultProvider(""))
+	require.Error(t, err)
+}
+
+func TestProjectDefaultProvider(t *testing.T) {
+	t.Setenv("VAGRANT_DEFAULT_PROVIDER", "")
+	t.Setenv("VAGRANT_PREFERRED_PROVIDERS", "")
+	manager := plugin.TestManager(t,
+		testProvider(t, "virtualbox", true, 5),
+		testProvider(t, "docker", true, 10),
+	)
+	tp := TestProject(t, WithPluginManager(manager), WithDefaultProvider("vmware"))
+
+	// A requested provider which is not installed is ignored
+	// unless it is forced
+	name, err := tp.DefaultProvider(&core.DefaultProviderOptions{CheckUsable: true})
+	require.NoError(t, err)
+	require.Equal(t, "docker", name)
+
+	_, err = tp.DefaultProvider(&core.DefaultProviderOptions{CheckUsable: true, ForceDefault: true})
+	require.ErrorIs(t, err, ErrProviderNotInstalled)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// Interfaces which default arguments of a dynamic call are
+// commonly requested as. A default value implementing one
+// of these interfaces is dropped when the caller provides
+// its own value for the interface.
+var dynamicArgInterfaces = []reflect.Type{
+	reflect.TypeOf((*terminal.UI)(nil)).Elem(),
+	reflect.TypeOf((*hclog.Logger)(nil)).Elem(),
+}
+
+// Build the arguments for a dynamic call. Default values are
+// added before the caller arguments so caller values of the
+// same type or with the same name replace the defaults. Since
+// defaults like the UI are usually requested by interface, a
+// caller value of a different type implementing the same
+// interface would otherwise be ambiguous with the default, so
+// those defaults are not included.
+func dynamicArgs(
+	typed []interface{}, // default typed values
+	named map[string]interface{}, // default named values
+	args []argmapper.Arg, // caller provided arguments
+) []argmapper.Arg {
+	result := make([]argmapper.Arg, 0, len(typed)+len(named)+len(args))
+	for k, v := range named {
+		result = append(result, argmapper.Named(k, v))
+	}
+
+	provided := map[reflect.Type]bool{}
+	for _, v := range typed {
+		if i := dynamicArgInterface(v); i != nil {
+			if _, ok := provided[i]; !ok {
+				provided[i] = dynamicArgProvided(i, args)
+			}
+			if provided[i] {
+				continue
+			}
+		}
+
+		result = append(result, argmapper.Typed(v))
+	}
+
+	return append(result, args...)
+}
+
+// Returns the interface from dynamicArgInterfaces implemented
+// by the value, if any
+func dynamicArgInterface(v interface{}) reflect.Type {
+	if v == nil {
+		return nil
+	}
+
+	t := reflect.TypeOf(v)
+	for _, i := range dynamicArgInterfaces {
+		if t.Implements(i) {
+			return i
+		}
+	}
+
+	return nil
+}
+
+// Checks if the arguments directly provide a value of the
+// given type by calling a function requiring only that type
+func dynamicArgProvided(t reflect.Type, args []argmapper.Arg) bool {
+	fn := reflect.MakeFunc(
+		reflect.FuncOf([]reflect.Type{t}, nil, false),
+		func([]reflect.Value) []reflect.Value { return nil },
+	)
+	f, err := argmapper.NewFunc(fn.Interface())
+	if err != nil {
+		return false
+	}
+
+	result := f.Call(args...)
+	return result.Err() == nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+
+	"github.com/hashicorp/vagrant/internal/config"
+)
+
+// Cache key for the resolved environment
+const environmentCacheKey = "environment"
+
+// Context key for the environment resolved for an operation
+type environmentKey struct{}
+
+// Environment resolved for an operation. The value is nil
+// if the environment could not be resolved.
+type operationEnvironment struct {
+	env *ResolvedConfig
+}
+
+// Matches ${VAR} references within label values
+var labelEnvPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Replace ${VAR} references with the value from the environment.
+// Other uses of $ are left as is.
+func expandLabel(v string) string {
+	return labelEnvPattern.ReplaceAllStringFunc(v, func(m string) string {
+		return os.Getenv(m[2 : len(m)-1])
+	})
+}
+
+// ResolvedConfig is a read-only view of the effective configuration
+// of a basis. All configuration sources have been merged and values
+// have been interpolated. It is provided to plugins as a typed
+// argument on dynamic calls. Plugins running in their own process
+// request *commandargs.ResolvedConfig.
+type ResolvedConfig struct {
+	runnerEnabled bool
+	labels        map[string]string
+	pluginAllow   []string
+	pluginDeny    []string
+	components    []*config.Component
+}
+
+// Create a resolved view of the given configuration
+func newResolvedConfig(c *config.Config) *ResolvedConfig {
+	r := &ResolvedConfig{
+		labels: map[string]string{},
+	}
+	if c == nil {
+		return r
+	}
+
+	r.runnerEnabled = c.Runner.IsEnabled()
+
+	for k, v := range c.Labels {
+		r.labels[k] = expandLabel(v)
+	}
+
+	if c.Plugins != nil {
+		r.pluginAllow = append([]string{}, c.Plugins.Allow...)
+		r.pluginDeny = append([]string{}, c.Plugins.Deny...)
+	}
+
+	r.components = append(r.components, c.Components...)
+
+	return r
+}
+
+// RunnerEnabled returns if runners are enabled
+func (r *ResolvedConfig) RunnerEnabled() bool {
+	return r.runnerEnabled
+}
+
+// Label returns the value of the label and if it was set
+func (r *ResolvedConfig) Label(key string) (string, bool) {
+	v, ok := r.labels[key]
+	return v, ok
+}
+
+// Labels returns a copy of all labels
+func (r *ResolvedConfig) Labels() map[string]string {
+	result := make(map[string]string, len(r.labels))
+	for k, v := range r.labels {
+		result[k] = v
+	}
+
+	return result
+}
+
+// PluginPolicy returns a copy of the configured plugin
+// restrictions. If no restrictions are configured nil
+// is returned.
+func (r *ResolvedConfig) PluginPolicy() *PluginPolicy {
+	if r.pluginAllow == nil && r.pluginDeny == nil {
+		return nil
+	}
+
+	return &PluginPolicy{
+		Allow: append([]string{}, r.pluginAllow...),
+		Deny:  append([]string{}, r.pluginDeny...),
+	}
+}
+
+// ComponentConfig returns the configuration values defined for
+// the component with the given type and plugin name. If no
+// configuration is defined nil is returned. Components which
+// declare a schema receive their configuration when loaded
+// (see ConfigurableComponent).
+func (r *ResolvedConfig) ComponentConfig(
+	typ component.Type, // type of component
+	name string, // name of the plugin
+) (map[string]interface{}, error) {
+	comp := (&config.Config{Components: r.components}).Component(typ.String(), name)
+	if comp == nil {
+		return nil, nil
+	}
+
+	values, err := comp.Values()
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration for %s %s: %w", typ.String(), name, err)
+	}
+
+	return values, nil
+}
+
+// Environment returns the fully resolved configuration for the
+// basis. The result is cached until the basis or its configuration
+// is reloaded.
+func (b *Basis) Environment() (*ResolvedConfig, error) {
+	if r, ok := b.cache.Fetch(environmentCacheKey); ok {
+		return r.(*ResolvedConfig), nil
+	}
+
+	cfg, err := b.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	r := newResolvedConfig(cfg)
+	b.cache.Register(environmentCacheKey, r)
+
+	return r, nil
+}
+
+// Resolve the environment once for an operation. Dynamic calls made
+// using the returned context receive the same environment, even if
+// the configuration is reloaded while the operation is running.
+func (b *Basis) withEnvironment(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(environmentKey{}).(*operationEnvironment); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, environmentKey{},
+		&operationEnvironment{env: b.callEnvironment(ctx)})
+}
+
+// Returns the environment provided to dynamic calls. Failing to
+// resolve the environment is not an error since most functions do
+// not request it. A function which does will fail because the
+// argument is not available. If the environment cannot be resolved
+// nil is returned.
+func (b *Basis) callEnvironment(ctx context.Context) *ResolvedConfig {
+	if o, ok := ctx.Value(environmentKey{}).(*operationEnvironment); ok {
+		return o.env
+	}
+
+	env, err := b.Environment()
+	if err != nil {
+		b.logger.Warn("failed to resolve environment, not providing to plugins",
+			"error", err,
+		)
+
+		return nil
+	}
+
+	return env
+}
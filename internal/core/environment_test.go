@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vagrant/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisEnvironment(t *testing.T) {
+	t.Setenv("VAGRANT_TEST_TEAM", "ops")
+
+	enabled := true
+	b := TestBasis(t,
+		WithConfig(&config.Config{
+			Runner: &config.Runner{Enabled: &enabled},
+			Labels: map[string]string{
+				"team":  "${VAGRANT_TEST_TEAM}",
+				"price": "$5 for $VAGRANT_TEST_TEAM",
+			},
+		}),
+	)
+
+	env, err := b.Environment()
+	require.NoError(t, err)
+	require.True(t, env.RunnerEnabled())
+	team, ok := env.Label("team")
+	require.True(t, ok)
+	require.Equal(t, "ops", team)
+	price, _ := env.Label("price")
+	require.Equal(t, "$5 for $VAGRANT_TEST_TEAM", price)
+	require.Nil(t, env.PluginPolicy())
+
+	// Modifying returned values should not modify the environment
+	env.Labels()["team"] = "dev"
+	team, _ = env.Label("team")
+	require.Equal(t, "ops", team)
+
+	// Resolution is cached until reload
+	again, err := b.Environment()
+	require.NoError(t, err)
+	require.Same(t, env, again)
+
+	require.NoError(t, b.Reload())
+	reloaded, err := b.Environment()
+	require.NoError(t, err)
+	require.NotSame(t, env, reloaded)
+}
+
+func TestBasisEnvironmentDynamicArg(t *testing.T) {
+	b := TestBasis(t,
+		WithConfig(&config.Config{
+			Labels: map[string]string{"team": "ops"},
+		}),
+	)
+
+	result, err := b.callDynamicFunc(context.Background(), b.logger,
+		func(env *ResolvedConfig) string {
+			v, _ := env.Label("team")
+			return v
+		},
+		(*string)(nil),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "ops", result)
+}
+
+func TestBasisEnvironmentOperation(t *testing.T) {
+	b := TestBasis(t)
+	env := func(env *ResolvedConfig) *ResolvedConfig { return env }
+
+	// The environment is resolved once for the operation
+	ctx := b.withEnvironment(context.Background())
+	before, err := b.callDynamicFunc(ctx, b.logger, env, (**ResolvedConfig)(nil))
+	require.NoError(t, err)
+	require.NoError(t, b.Reload())
+	after, err := b.callDynamicFunc(ctx, b.logger, env, (**ResolvedConfig)(nil))
+	require.NoError(t, err)
+	require.Same(t, before, after)
+
+	// Calls outside the operation use the reloaded environment
+	current, err := b.callDynamicFunc(context.Background(), b.logger, env, (**ResolvedConfig)(nil))
+	require.NoError(t, err)
+	require.NotSame(t, before, current)
+
+	// Calls which do not request the environment succeed
+	// when it could not be resolved
+	ctx = context.WithValue(context.Background(), environmentKey{}, &operationEnvironment{})
+	result, err := b.callDynamicFunc(ctx, b.logger, func() int32 { return 1 }, (*int32)(nil))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result)
+
+	_, err = b.callDynamicFunc(ctx, b.logger, env, (**ResolvedConfig)(nil))
+	require.Error(t, err)
+}
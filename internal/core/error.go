@@ -4,9 +4,110 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 
 	"google.golang.org/genproto/googleapis/rpc/status"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+)
+
+var (
+	// ErrServerUnreachable is returned when the Vagrant server cannot be reached
+	ErrServerUnreachable = errors.New("cannot reach Vagrant server")
+
+	// ErrServerUnauthenticated is returned when the Vagrant server rejects
+	// the client credentials
+	ErrServerUnauthenticated = errors.New("not authenticated with Vagrant server")
+
+	// ErrServerIncompatible is returned when the Vagrant server API version
+	// is not compatible with the client
+	ErrServerIncompatible = errors.New("Vagrant server version is incompatible")
+
+	// ErrBasisTimeout is returned when constructing the basis
+	// does not complete within the basis timeout
+	ErrBasisTimeout = errors.New("basis construction timed out")
+
+	// ErrProjectClosed is returned when attempting to use a
+	// project which has already been closed
+	ErrProjectClosed = errors.New("project is closed")
+
+	// ErrPluginDenied is returned when a plugin is blocked by the
+	// configured plugin policy
+	ErrPluginDenied = errors.New("plugin denied by policy")
+
+	// ErrPluginCapReached is returned when starting a plugin would
+	// exceed the maximum number of running plugin processes
+	ErrPluginCapReached = plugin.ErrProcessLimit
+
+	// ErrHostNotDetected is returned when no host plugin is
+	// detected for the current platform
+	ErrHostNotDetected = errors.New("failed to detect host plugin for current platform")
+
+	// ErrNoHostFactory is returned when the host is requested
+	// but no host plugins are installed
+	ErrNoHostFactory = errors.New("no host plugins installed")
+
+	// ErrProviderNotInstalled is returned when the provider requested
+	// as the default is not provided by any installed plugin
+	ErrProviderNotInstalled = errors.New("provider not installed")
+
+	// ErrProviderNotUsable is returned when the provider requested as
+	// the default is installed but reports it cannot be used
+	ErrProviderNotUsable = errors.New("provider not usable")
+
+	// ErrNoCommunicator is returned when no communicator is configured
+	// for a target and the default communicator is not available
+	ErrNoCommunicator = errors.New("no communicator configured")
+
+	// ErrCommunicatorNotInstalled is returned when the configured
+	// communicator plugin is not installed
+	ErrCommunicatorNotInstalled = errors.New("communicator plugin not installed")
+
+	// ErrCapabilitiesUnsupported is returned when a component is
+	// not able to list the capabilities it supports
+	ErrCapabilitiesUnsupported = errors.New("component does not list capabilities")
+
+	// ErrCapabilityUnsupported is returned when an operation requires
+	// a capability which the host or provider does not support
+	ErrCapabilityUnsupported = errors.New("required capability not supported")
+
+	// ErrTargetDependencyCycle is returned when the dependencies
+	// between targets form a cycle
+	ErrTargetDependencyCycle = errors.New("target dependency cycle detected")
+
+	// ErrTargetDependencyFailed is returned for a target which was
+	// not run because a target it depends on failed
+	ErrTargetDependencyFailed = errors.New("target dependency failed")
+
+	// ErrConflictingOptions is returned when options which set
+	// the same values are used together
+	ErrConflictingOptions = errors.New("conflicting options")
+
+	// ErrShutdownTimeout is returned when closing the basis does
+	// not complete within the shutdown timeout
+	ErrShutdownTimeout = errors.New("shutdown timed out")
+
+	// ErrCommandChainLoop is returned when a command is run by
+	// a command it is already running within
+	ErrCommandChainLoop = errors.New("command chain loop detected")
+
+	// ErrCommandChainDepth is returned when commands running
+	// other commands exceed the maximum chain depth
+	ErrCommandChainDepth = errors.New("command chain too deep")
+
+	// ErrComponentNotFound is returned when no component has
+	// the requested name
+	ErrComponentNotFound = errors.New("component not found")
+
+	// ErrComponentAmbiguous is returned when components of more
+	// than one type have the requested name
+	ErrComponentAmbiguous = errors.New("component name is ambiguous")
+
+	// ErrCredentialNotFound is returned by a credential provider
+	// when no secret exists for the requested name
+	ErrCredentialNotFound = commandargs.ErrCredentialNotFound
 )
 
 type CommandError interface {
@@ -21,6 +122,26 @@ type runError struct {
 	status   *status.Status
 }
 
+// Create an error for a failed command from the result of
+// the command's execute function. When the command ran but
+// exited with a non-zero exit code, the error wraps a
+// CommandExitError. When the command returned a structured
+// failure, the failure is included in the status.
+func newRunError(command string, result interface{}, err error) *runError {
+	r := &runError{err: err}
+	if f := commandFailure(err); f != nil {
+		r.status = f.GRPCStatus().Proto()
+	}
+	if result != nil {
+		r.exitCode = result.(int32)
+	}
+	if err == nil && r.exitCode != 0 {
+		r.err = &CommandExitError{Command: command, Code: r.exitCode}
+	}
+
+	return r
+}
+
 // Error implements error
 func (r *runError) Error() string {
 	if r.err != nil {
@@ -29,6 +150,11 @@ func (r *runError) Error() string {
 	return fmt.Sprintf("non-zero exit code: %d", r.exitCode)
 }
 
+// Unwrap returns the underlying error
+func (r *runError) Unwrap() error {
+	return r.err
+}
+
 // runError implements CommandError
 func (r *runError) ExitCode() int32 {
 	return r.exitCode
@@ -38,3 +164,32 @@ func (r *runError) ExitCode() int32 {
 func (r *runError) Status() *status.Status {
 	return r.status
 }
+
+// CommandExitError is returned when a command ran to completion
+// but exited with a non-zero exit code. It is not returned when
+// the command could not be started.
+type CommandExitError struct {
+	Command string // command which was run
+	Code    int32  // exit code of the command
+}
+
+// Error implements error
+func (e *CommandExitError) Error() string {
+	return fmt.Sprintf("command %q failed with non-zero exit code: %d", e.Command, e.Code)
+}
+
+// ExitCode returns the exit code of the command
+func (e *CommandExitError) ExitCode() int32 {
+	return e.Code
+}
+
+// OperationNotFoundError is returned when an operation is
+// not in flight
+type OperationNotFoundError struct {
+	ID string // requested operation id
+}
+
+// Error implements error
+func (e *OperationNotFoundError) Error() string {
+	return fmt.Sprintf("operation %q is not in flight", e.ID)
+}
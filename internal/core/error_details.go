@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDetails is a structured description of an error for tools
+// which parse output. The code is stable and can be used to handle
+// specific failures programmatically.
+type ErrorDetails struct {
+	Code      string `json:"code"`                // stable error code
+	Message   string `json:"message"`             // human readable message
+	Component string `json:"component,omitempty"` // component which failed
+	Operation string `json:"operation,omitempty"` // operation which failed
+	Resource  string `json:"resource,omitempty"`  // resource the operation was for
+}
+
+// Stable codes for known errors. Codes must never be changed once
+// added since tools depend on them.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{ErrServerUnreachable, "server_unreachable"},
+	{ErrServerUnauthenticated, "server_unauthenticated"},
+	{ErrServerIncompatible, "server_incompatible"},
+	{ErrBasisTimeout, "basis_timeout"},
+	{ErrProjectClosed, "project_closed"},
+	{ErrPluginDenied, "plugin_denied"},
+	{ErrPluginCapReached, "plugin_cap_reached"},
+	{ErrHostNotDetected, "host_not_detected"},
+	{ErrNoHostFactory, "no_host_plugins"},
+	{ErrProviderNotInstalled, "provider_not_installed"},
+	{ErrProviderNotUsable, "provider_not_usable"},
+	{ErrNoCommunicator, "no_communicator"},
+	{ErrCommunicatorNotInstalled, "communicator_not_installed"},
+	{ErrCapabilitiesUnsupported, "capabilities_unsupported"},
+	{ErrCapabilityUnsupported, "capability_unsupported"},
+	{ErrTargetDependencyCycle, "target_dependency_cycle"},
+	{ErrTargetDependencyFailed, "target_dependency_failed"},
+	{ErrConflictingOptions, "conflicting_options"},
+	{ErrShutdownTimeout, "shutdown_timeout"},
+	{ErrCommandChainLoop, "command_chain_loop"},
+	{ErrCommandChainDepth, "command_chain_depth"},
+	{ErrComponentNotFound, "component_not_found"},
+	{ErrComponentAmbiguous, "component_ambiguous"},
+	{ErrCredentialNotFound, "credential_not_found"},
+	{context.Canceled, "cancelled"},
+	{context.DeadlineExceeded, "timeout"},
+}
+
+// NewErrorDetails creates the structured description of the error
+func NewErrorDetails(err error) *ErrorDetails {
+	d := &ErrorDetails{Code: "unknown", Message: err.Error()}
+
+	var srvErr *serverError
+	if errors.As(err, &srvErr) {
+		d.Operation = srvErr.op
+		d.Resource = srvErr.id
+	}
+
+	var capErr *CapabilityUnsupportedError
+	if errors.As(err, &capErr) {
+		d.Component = capErr.Component
+		d.Operation = capErr.Operation
+	}
+
+	if f := commandFailure(err); f != nil && f.Code != "" {
+		d.Code = f.Code
+		d.Message = f.Message
+		return d
+	}
+
+	var exitErr *CommandExitError
+	var opErr *OperationNotFoundError
+	var crashErr *PluginCrashError
+	switch {
+	case errors.As(err, &exitErr):
+		d.Code = "command_exit"
+		d.Operation = exitErr.Command
+		return d
+	case errors.As(err, &opErr):
+		d.Code = "operation_not_found"
+		d.Resource = opErr.ID
+		return d
+	case errors.As(err, &crashErr):
+		d.Code = "plugin_crash"
+		d.Component = crashErr.Plugin
+		d.Resource = crashErr.Bundle
+		return d
+	}
+
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			d.Code = c.code
+			return d
+		}
+	}
+
+	// Errors from plugins and the server carry a status code
+	if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
+		d.Code = "rpc_" + strings.ToLower(s.Code().String())
+	}
+
+	return d
+}
+
+// JSON returns the details serialized as JSON
+func (d *ErrorDetails) JSON() string {
+	v, _ := json.Marshal(d)
+	return string(v)
+}
+
+// errorReporter is implemented by UIs which output structured
+// error details
+type errorReporter interface {
+	ReportError(*ErrorDetails)
+}
+
+// Check if the UI, or the UI it wraps, outputs structured
+// error details
+func reportsErrors(ui terminal.UI) bool {
+	for {
+		switch u := ui.(type) {
+		case *captureUI:
+			ui = u.UI
+		case *filterUI:
+			ui = u.UI
+		case *renderUI:
+			ui = u.UI
+		case errorReporter:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+// Output the structured error details if supported by the UI.
+// Otherwise, structured command failures are output as a
+// formatted error block.
+func reportError(
+	ui terminal.UI, // UI to report the error to
+	err error, // error to report
+	component string, // component which failed
+	operation string, // operation which failed
+) {
+	if err == nil {
+		return
+	}
+	r, ok := ui.(errorReporter)
+	if !ok || !reportsErrors(ui) {
+		outputCommandFailure(ui, commandFailure(err))
+		return
+	}
+
+	d := NewErrorDetails(err)
+	if d.Component == "" {
+		d.Component = component
+	}
+	if d.Operation == "" {
+		d.Operation = operation
+	}
+
+	r.ReportError(d)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewErrorDetails(t *testing.T) {
+	d := NewErrorDetails(fmt.Errorf("%w: reticulating", ErrProjectClosed))
+	require.Equal(t, "project_closed", d.Code)
+	require.Contains(t, d.Message, "reticulating")
+
+	d = NewErrorDetails(newRunError("up", int32(3), nil))
+	require.Equal(t, "command_exit", d.Code)
+	require.Equal(t, "up", d.Operation)
+
+	d = NewErrorDetails(newServerError(
+		status.Error(codes.NotFound, "missing"), "load target", "RES1"))
+	require.Equal(t, "rpc_notfound", d.Code)
+	require.Equal(t, "load target", d.Operation)
+	require.Equal(t, "RES1", d.Resource)
+
+	d = NewErrorDetails(errors.New("something else"))
+	require.Equal(t, "unknown", d.Code)
+}
+
+func TestMachineReadableUIReportError(t *testing.T) {
+	rec := &testRecordUI{}
+	ui := NewMachineReadableUI(rec, "default")
+
+	reportError(ui, fmt.Errorf("%w: box", ErrComponentNotFound), "box", "up")
+	reportError(ui, nil, "box", "up")
+	require.Len(t, rec.lines, 1)
+
+	parts := strings.SplitN(rec.lines[0], ",", 5)
+	require.Equal(t, "error", parts[2])
+	require.Equal(t, "component_not_found", parts[3])
+
+	var d ErrorDetails
+	raw := strings.ReplaceAll(parts[4], "%!(VAGRANT_COMMA)", ",")
+	require.NoError(t, json.Unmarshal([]byte(raw), &d))
+	require.Equal(t, "box", d.Component)
+	require.Equal(t, "up", d.Operation)
+}
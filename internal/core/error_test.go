@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestNewRunError(t *testing.T) {
+	// Non-zero exit code provides a typed exit error
+	err := error(newRunError("up", int32(2), nil))
+	var exitErr *CommandExitError
+	require.ErrorAs(t, err, &exitErr)
+	require.Equal(t, "up", exitErr.Command)
+	require.Equal(t, int32(2), exitErr.Code)
+	require.Equal(t, int32(2), err.(CommandError).ExitCode())
+
+	// Failure to run the command is not an exit error
+	startErr := errors.New("failed to start")
+	err = newRunError("up", nil, startErr)
+	require.ErrorIs(t, err, startErr)
+	require.False(t, errors.As(err, &exitErr))
+}
+
+func TestCommandFailure(t *testing.T) {
+	failure := &CommandFailure{
+		Code:    "box_not_found",
+		Message: "box 'hashicorp/bionic64' could not be found",
+		Details: map[string]string{"suggestion": "run vagrant box add"},
+	}
+
+	// Failures are included in the status of the run error
+	for _, err := range []error{
+		fmt.Errorf("up: %w", failure),
+		failure.GRPCStatus().Err(),
+	} {
+		st := newRunError("up", nil, err).Status()
+		require.NotNil(t, st)
+		require.Equal(t, failure.Message, st.Message)
+		details := status.FromProto(st).Details()
+		require.Len(t, details, 1)
+		info := details[0].(*errdetails.ErrorInfo)
+		require.Equal(t, CommandFailureDomain, info.Domain)
+		require.Equal(t, "box_not_found", info.Reason)
+		require.Equal(t, failure.Details, info.Metadata)
+		require.Equal(t, "box_not_found", NewErrorDetails(err).Code)
+	}
+
+	// Plain errors do not provide a status
+	require.Nil(t, newRunError("up", nil, errors.New("failed")).Status())
+	require.Nil(t, commandFailure(status.Error(codes.NotFound, "missing")))
+
+	// Failures from a command run are output as an error block
+	ui := &testStyledUI{}
+	b := TestBasis(t, WithUI(ui))
+	load := func(context.Context, string) (*Component, error) {
+		return &Component{Value: &testFailingCommand{err: failure}}, nil
+	}
+	_, err := b.runCommandComponent(context.Background(), &vagrant_server.Job_CommandOp{
+		Command:   "up",
+		Component: &vagrant_server.Component{Name: "up"},
+	}, nil, load)
+	require.Error(t, err)
+	require.NotNil(t, err.(CommandError).Status())
+	require.Equal(t, []string{
+		"Error: box 'hashicorp/bionic64' could not be found\n" +
+			"  code: box_not_found\n" +
+			"  suggestion: run vagrant box add",
+	}, ui.lines)
+	require.Contains(t, b.LastOperationOutput(), "suggestion: run vagrant box add")
+
+	// Plain errors are not output as a block
+	ui.lines = nil
+	reportError(ui, newRunError("up", nil, errors.New("failed")), "up", "up")
+	require.Empty(t, ui.lines)
+}
+
+// testFailingCommand is a command which fails with the error
+type testFailingCommand struct {
+	err error
+}
+
+func (c *testFailingCommand) ExecuteFunc([]string) interface{} {
+	return func() (int32, error) {
+		return 1, c.err
+	}
+}
+
+func (c *testFailingCommand) CommandInfoFunc() interface{} {
+	return func() *component.CommandInfo {
+		return &component.CommandInfo{}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LifecycleEventType is the kind of lifecycle event
+type LifecycleEventType string
+
+// Lifecycle events emitted by the basis
+const (
+	EventPluginStarted     LifecycleEventType = "plugin-started"
+	EventPluginClosed      LifecycleEventType = "plugin-closed"
+	EventPluginReaped      LifecycleEventType = "plugin-reaped"
+	EventComponentCreated  LifecycleEventType = "component-created"
+	EventOperationStarted  LifecycleEventType = "operation-started"
+	EventOperationFinished LifecycleEventType = "operation-finished"
+	EventConfigReloaded    LifecycleEventType = "config-reloaded"
+)
+
+// Default number of events buffered for a subscriber
+const defaultEventBuffer = 64
+
+// LifecycleEvent describes activity within the basis like
+// plugins being started or operations being run.
+type LifecycleEvent struct {
+	Type      LifecycleEventType // type of event
+	Name      string             // name of the plugin or operation
+	Component string             // component type, if applicable
+	Error     error              // error for finished operations or failed reloads
+	Time      time.Time          // time the event was emitted
+}
+
+// eventStream distributes lifecycle events to subscribers. Events
+// are never blocked on. If a subscriber's buffer is full the event
+// is dropped for that subscriber.
+type eventStream struct {
+	dropped uint64
+	nextID  uint64
+	subs    map[uint64]chan *LifecycleEvent
+
+	m sync.Mutex
+}
+
+func newEventStream() *eventStream {
+	return &eventStream{
+		subs: map[uint64]chan *LifecycleEvent{},
+	}
+}
+
+// Add a new subscriber. The returned function must be called
+// to remove the subscriber and close the channel.
+func (s *eventStream) subscribe(buffer int) (<-chan *LifecycleEvent, func()) {
+	if buffer < 1 {
+		buffer = defaultEventBuffer
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	id := s.nextID
+	s.nextID++
+	ch := make(chan *LifecycleEvent, buffer)
+	s.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.m.Lock()
+			defer s.m.Unlock()
+
+			delete(s.subs, id)
+			close(ch)
+		})
+	}
+}
+
+// Send the event to all subscribers without blocking
+func (s *eventStream) emit(typ LifecycleEventType, name, component string, err error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if len(s.subs) == 0 {
+		return
+	}
+
+	e := &LifecycleEvent{
+		Type:      typ,
+		Name:      name,
+		Component: component,
+		Error:     err,
+		Time:      time.Now(),
+	}
+
+	for _, ch := range s.subs {
+		select {
+		case ch <- e:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	}
+}
+
+// Number of events dropped due to full subscriber buffers
+func (s *eventStream) droppedCount() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// SubscribeEvents registers for lifecycle events from the basis.
+// Events are buffered up to the given size (a default is used if
+// less than one) and dropped when the buffer is full so a slow
+// consumer never stalls the basis. The returned function must be
+// called to unsubscribe.
+func (b *Basis) SubscribeEvents(buffer int) (<-chan *LifecycleEvent, func()) {
+	return b.events.subscribe(buffer)
+}
+
+// Provides the event stream for the basis
+func (b *Basis) lifecycle() *eventStream {
+	return b.events
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+func TestEventStream(t *testing.T) {
+	s := newEventStream()
+
+	// Emitting without subscribers is a no-op
+	s.emit(EventPluginStarted, "test", "", nil)
+
+	ch, cancel := s.subscribe(1)
+	s.emit(EventOperationStarted, "up", "", nil)
+	s.emit(EventOperationFinished, "up", "", nil)
+
+	e := <-ch
+	require.Equal(t, EventOperationStarted, e.Type)
+	require.Equal(t, "up", e.Name)
+
+	// Second event was dropped since the buffer was full
+	require.Equal(t, uint64(1), s.droppedCount())
+
+	cancel()
+	cancel()
+	_, ok := <-ch
+	require.False(t, ok)
+	s.emit(EventOperationStarted, "up", "", nil)
+}
+
+func TestBasisComponentEvents(t *testing.T) {
+	guest := BuildTestGuestPlugin("myguest", "")
+	guest.On("Close").Return(nil)
+	myguest := func() *plugin.Plugin {
+		return plugin.TestPlugin(t,
+			guest,
+			plugin.WithPluginName("myguest"),
+			plugin.WithPluginTypes(component.GuestType),
+		)
+	}
+	b := TestBasis(t, WithPluginManager(testProcessManager(t, myguest)))
+
+	ch, cancel := b.SubscribeEvents(10)
+	defer cancel()
+
+	c, err := b.component(b.ctx, component.GuestType, "myguest")
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+
+	types := []LifecycleEventType{}
+	for len(ch) > 0 {
+		e := <-ch
+		require.Equal(t, "myguest", e.Name)
+		require.Equal(t, component.GuestType.String(), e.Component)
+		types = append(types, e.Type)
+	}
+	require.Equal(t, []LifecycleEventType{
+		EventPluginStarted, EventComponentCreated, EventPluginClosed,
+	}, types)
+}
+
+func TestBasisComponentEventsCached(t *testing.T) {
+	myhost := func() *plugin.Plugin {
+		return plugin.TestPlugin(t,
+			BuildTestHostPlugin("myhost", ""),
+			plugin.WithPluginName("myhost"),
+			plugin.WithPluginTypes(component.HostType),
+		)
+	}
+	b := TestBasis(t, WithPluginManager(testProcessManager(t, myhost)))
+
+	ch, cancel := b.SubscribeEvents(10)
+	defer cancel()
+
+	// Loading the cached instance does not start the plugin again
+	for i := 0; i < 2; i++ {
+		c, err := b.component(b.ctx, component.HostType, "myhost")
+		require.NoError(t, err)
+		c.Release()
+	}
+
+	started := 0
+	for len(ch) > 0 {
+		if e := <-ch; e.Type == EventPluginStarted {
+			started++
+		}
+	}
+	require.Equal(t, 1, started)
+}
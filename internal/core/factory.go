@@ -5,6 +5,7 @@ package core
 
 import (
 	"context"
+	"sync"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cacher"
@@ -31,6 +32,8 @@ type Factory struct {
 	logger  hclog.Logger
 	plugins *plugin.Manager
 	ui      terminal.UI
+
+	m sync.Mutex
 }
 
 func NewFactory(
@@ -60,6 +63,44 @@ func (f *Factory) Close() error {
 	return f.cleanup.Close()
 }
 
+// Fetch a cached instance by resource id
+func (f *Factory) fetch(resourceId string) (interface{}, bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	return f.cache.Fetch(resourceId)
+}
+
+// Cache the instance for the resource id. If another instance
+// has already been cached for the resource id, and it has not
+// been closed, the cached instance is returned and false is
+// returned to indicate the instance was not registered.
+func (f *Factory) register(resourceId string, s Scope) (Scope, bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if existing, ok := f.cache.Fetch(resourceId); ok {
+		c, ok := existing.(interface{ Closed() bool })
+		if !ok || !c.Closed() {
+			return existing.(Scope), false
+		}
+	}
+	f.cache.Register(resourceId, s)
+
+	return s, true
+}
+
+// Remove the instance from the cache. The cache entry is only
+// removed if it is the given instance.
+func (f *Factory) unregister(resourceId string, s Scope) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if existing, ok := f.cache.Fetch(resourceId); ok && existing == s {
+		f.cache.Delete(resourceId)
+	}
+}
+
 func (f *Factory) NewBasis(resourceId string, opts ...BasisOption) (*Basis, error) {
 	f.logger.Trace("factory basis load started")
 	defer func() { f.logger.Trace("factory basis load completed") }()
@@ -67,7 +108,7 @@ func (f *Factory) NewBasis(resourceId string, opts ...BasisOption) (*Basis, erro
 	// If we have a name, check if it's registered and return
 	// the existing basis if available
 	if resourceId != "" {
-		if b, ok := f.cache.Fetch(resourceId); ok {
+		if b, ok := f.fetch(resourceId); ok {
 			return b.(*Basis), nil
 		}
 	}
@@ -88,8 +129,8 @@ func (f *Factory) NewBasis(resourceId string, opts ...BasisOption) (*Basis, erro
 	if b.logger == nil {
 		b.logger = f.logger
 	}
-	if b.ui == nil {
-		b.ui = f.ui
+	if b.uiBase == nil {
+		b.uiBase = f.ui
 	}
 	if b.plugins == nil {
 		b.plugins = f.plugins
@@ -97,7 +138,7 @@ func (f *Factory) NewBasis(resourceId string, opts ...BasisOption) (*Basis, erro
 
 	// Now that we have the basis information loaded, check if
 	// we have a cached version and return that if so
-	if existingB, ok := f.cache.Fetch(b.basis.ResourceId); ok {
+	if existingB, ok := f.fetch(b.basis.ResourceId); ok {
 		f.logger.Debug("found existing basis in cache, closing new instance")
 		if err := b.Close(); err != nil {
 			return nil, err
@@ -116,12 +157,21 @@ func (f *Factory) NewBasis(resourceId string, opts ...BasisOption) (*Basis, erro
 		return nil, err
 	}
 
-	// Now that basis is fully setup, add it to the cache
-	f.cache.Register(b.basis.ResourceId, b)
+	// Now that basis is fully setup, add it to the cache. If
+	// the basis was loaded concurrently, use the cached basis
+	// so closers are only registered once.
+	if existingB, ok := f.register(b.basis.ResourceId, b); !ok {
+		f.logger.Debug("basis loaded concurrently, closing new instance")
+		if err := b.Close(); err != nil {
+			return nil, err
+		}
+
+		return existingB.(*Basis), nil
+	}
 
 	// Remove the basis from the cache when closed
 	b.Closer(func() error {
-		f.cache.Delete(b.basis.ResourceId)
+		f.unregister(b.basis.ResourceId, b)
 		return nil
 	})
 
@@ -166,34 +216,58 @@ func (f *Factory) NewProject(popts ...ProjectOption) (*Project, error) {
 
 	// Check if we already have an instance loaded
 	if p.project.ResourceId != "" {
-		if project, ok := f.cache.Fetch(p.project.ResourceId); ok {
-			f.logger.Debug("found existing project in cache, closing new instance")
-			if err = p.Close(); err != nil {
-				return nil, err
+		if project, ok := f.fetch(p.project.ResourceId); ok {
+			if project.(*Project).Closed() {
+				// Stale entries can be left behind if a previous load
+				// failed, so remove it and continue with the new instance
+				f.logger.Debug("removing closed project from cache",
+					"project", p.project.ResourceId,
+				)
+				f.unregister(p.project.ResourceId, project.(*Project))
+			} else {
+				f.logger.Debug("found existing project in cache, closing new instance")
+				if err = p.Close(); err != nil {
+					return nil, err
+				}
+				project.(*Project).basis.projects.touch(project.(*Project))
+				return project.(*Project), nil
 			}
-			return project.(*Project), nil
 		}
 	}
 
 	// Initialize the project so it is ready for use
 	if err = p.Init(); err != nil {
+		p.Close()
+
 		return nil, err
 	}
 
+	// Cache the project. If the project was loaded concurrently,
+	// use the cached project so closers are only registered once.
+	if project, ok := f.register(p.project.ResourceId, p); !ok {
+		f.logger.Debug("project loaded concurrently, closing new instance")
+		if err = p.Close(); err != nil {
+			return nil, err
+		}
+
+		return project.(*Project), nil
+	}
+
 	// Close the project when the basis is closed
 	p.basis.Closer(func() error {
 		return p.Close()
 	})
 
-	// Cache the project
-	f.cache.Register(p.project.ResourceId, p)
-
 	// Remove the project from the cache when closed
 	p.Closer(func() error {
-		f.cache.Delete(p.project.ResourceId)
+		f.unregister(p.project.ResourceId, p)
+		p.basis.projects.remove(p)
 		return nil
 	})
 
+	// Track usage so unused projects can be evicted
+	p.basis.projects.touch(p)
+
 	return p, nil
 }
 
@@ -230,7 +304,7 @@ func (f *Factory) NewTarget(topts ...TargetOption) (*Target, error) {
 
 	// Check if we already have an instance loaded
 	if t.target.ResourceId != "" {
-		if target, ok := f.cache.Fetch(t.target.ResourceId); ok {
+		if target, ok := f.fetch(t.target.ResourceId); ok {
 			f.logger.Debug("found existing target in cache, closing new instance")
 			if err = t.Close(); err != nil {
 				return nil, err
@@ -254,17 +328,25 @@ func (f *Factory) NewTarget(topts ...TargetOption) (*Target, error) {
 		return nil, err
 	}
 
+	// Cache the target. If the target was loaded concurrently,
+	// use the cached target so closers are only registered once.
+	if target, ok := f.register(t.target.ResourceId, t); !ok {
+		f.logger.Debug("target loaded concurrently, closing new instance")
+		if err = t.Close(); err != nil {
+			return nil, err
+		}
+
+		return target.(*Target), nil
+	}
+
 	// Close the target when the project is closed
 	t.project.Closer(func() error {
 		return t.Close()
 	})
 
-	// Cache the target
-	f.cache.Register(t.target.ResourceId, t)
-
 	// Remove the target from the cache when closed
 	t.Closer(func() error {
-		f.cache.Delete(t.target.ResourceId)
+		f.unregister(t.target.ResourceId, t)
 		return nil
 	})
 
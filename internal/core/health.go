@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// HealthChecker is implemented by components which are
+// able to report their health. The function returned by
+// HealthFunc must return a bool indicating if the component
+// is healthy.
+type HealthChecker interface {
+	HealthFunc() interface{}
+}
+
+// HealthStatus is the reported health of a component
+type HealthStatus int
+
+const (
+	HealthUnknown   HealthStatus = iota // component does not report health
+	HealthHealthy                       // component reported healthy
+	HealthUnhealthy                     // component reported unhealthy or failed the check
+)
+
+func (h HealthStatus) String() string {
+	switch h {
+	case HealthHealthy:
+		return "healthy"
+	case HealthUnhealthy:
+		return "unhealthy"
+	default:
+		return "unknown"
+	}
+}
+
+// ComponentHealth loads the requested component and checks its
+// health. Components which do not implement HealthChecker
+// report HealthUnknown.
+func (b *Basis) ComponentHealth(
+	typ component.Type, // type of component
+	name string, // name of the component
+) (HealthStatus, error) {
+	c, err := b.component(b.ctx, typ, name)
+	if err != nil {
+		return HealthUnknown, err
+	}
+
+	return b.componentHealth(b.ctx, c)
+}
+
+// Run the health check of the component if supported
+func (b *Basis) componentHealth(
+	ctx context.Context, // context for the health check
+	c *Component, // component to check
+) (HealthStatus, error) {
+	h, ok := c.Value.(HealthChecker)
+	if !ok {
+		return HealthUnknown, nil
+	}
+
+	raw, err := b.callDynamicFunc(ctx, b.logger, h.HealthFunc(), (*bool)(nil),
+		argmapper.Typed(ctx),
+		argmapper.ConverterFunc(c.mappers...),
+	)
+	if err != nil {
+		return HealthUnhealthy, err
+	}
+
+	if healthy, ok := raw.(bool); !ok || !healthy {
+		return HealthUnhealthy, nil
+	}
+
+	return HealthHealthy, nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/stretchr/testify/require"
+)
+
+type testHealthGuestPlugin struct {
+	*TestGuestPlugin
+	healthy bool
+}
+
+func (p *testHealthGuestPlugin) HealthFunc() interface{} {
+	return func() bool { return p.healthy }
+}
+
+func TestBasisComponentHealth(t *testing.T) {
+	type test struct {
+		plugin   interface{}
+		expected HealthStatus
+	}
+
+	tests := []test{
+		{plugin: BuildTestGuestPlugin("myguest", ""), expected: HealthUnknown},
+		{plugin: &testHealthGuestPlugin{BuildTestGuestPlugin("myguest", ""), true}, expected: HealthHealthy},
+		{plugin: &testHealthGuestPlugin{BuildTestGuestPlugin("myguest", ""), false}, expected: HealthUnhealthy},
+	}
+
+	for _, tc := range tests {
+		myguest := plugin.TestPlugin(t,
+			tc.plugin,
+			plugin.WithPluginName("myguest"),
+			plugin.WithPluginTypes(component.GuestType),
+		)
+		b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myguest)))
+		health, err := b.ComponentHealth(component.GuestType, "myguest")
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, health)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/core"
+)
+
+// Name of the file within the basis data directory
+// which stores the name of the last detected host
+const hostCacheFile = "detected_host"
+
+// hostDetector ensures host detection is only performed once
+// per generation, even when requested concurrently. Resetting
+// the detector starts a new generation.
+type hostDetector struct {
+	current *hostGeneration
+
+	m sync.Mutex
+}
+
+// Result of a single host detection
+type hostGeneration struct {
+	once   sync.Once
+	result *HostDetection
+	err    error
+}
+
+// Run the detection function if it has not been run for the
+// current generation and return the shared result
+func (d *hostDetector) detect(fn func() (*HostDetection, error)) (*HostDetection, error) {
+	d.m.Lock()
+	if d.current == nil {
+		d.current = &hostGeneration{}
+	}
+	g := d.current
+	d.m.Unlock()
+
+	g.once.Do(func() {
+		g.result, g.err = fn()
+	})
+
+	return g.result, g.err
+}
+
+// Start a new generation so detection is run again
+func (d *hostDetector) reset() {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	d.current = nil
+}
+
+// Load the previously detected host. The host is validated by
+// running detection again. If the cached host is no longer
+// detected, the cache is removed and nil is returned.
+func (b *Basis) cachedHostDetection(ctx context.Context) *HostDetection {
+	if b.dir == nil {
+		return nil
+	}
+
+	path := b.dir.DataDir().Join(hostCacheFile).String()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			b.logger.Warn("failed to read detected host cache",
+				"path", path,
+				"error", err,
+			)
+		}
+
+		return nil
+	}
+
+	name := strings.TrimSpace(string(content))
+	if name == "" {
+		return nil
+	}
+
+	h, err := b.component(ctx, component.HostType, name)
+	if err == nil {
+		var detected bool
+		host := h.Value.(core.Host)
+		if detected, err = host.Detect(b.statebag); err == nil && detected {
+			b.logger.Debug("using cached host detection",
+				"name", name,
+			)
+
+			return &HostDetection{
+				Host:     host,
+				Name:     name,
+				Priority: h.plugin.ParentCount(),
+				Detected: true,
+			}
+		}
+	}
+
+	b.logger.Info("cached host is no longer detected, performing full detection",
+		"name", name,
+		"error", err,
+	)
+
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		b.logger.Warn("failed to remove detected host cache",
+			"path", path,
+			"error", err,
+		)
+	}
+
+	return nil
+}
+
+// Store the name of the detected host
+func (b *Basis) storeHostDetection(d *HostDetection) {
+	if b.dir == nil {
+		return
+	}
+
+	path := b.dir.DataDir().Join(hostCacheFile).String()
+	if err := os.WriteFile(path, []byte(d.Name), 0644); err != nil {
+		b.logger.Warn("failed to write detected host cache",
+			"path", path,
+			"error", err,
+		)
+	}
+}
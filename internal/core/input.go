@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+)
+
+// InputRequest describes a value requested from the user
+type InputRequest = commandargs.InputRequest
+
+// Prompter allows commands to request input from the user
+// through the core UI. A prompter is provided to commands
+// as a typed argument so plugins do not need direct access
+// to the terminal. Plugins running in their own process
+// request commandargs.Prompter.
+type Prompter struct {
+	ui terminal.UI
+
+	m sync.Mutex
+}
+
+// NewPrompter creates a new prompter using the given UI
+func NewPrompter(ui terminal.UI) *Prompter {
+	return &Prompter{ui: ui}
+}
+
+// Input requests a value from the user. If the UI is not
+// interactive the default value of the request is returned.
+// When no default value is set terminal.ErrNonInteractive
+// is returned.
+func (p *Prompter) Input(req *InputRequest) (string, error) {
+	if req == nil || req.Prompt == "" {
+		return "", fmt.Errorf("input request requires a prompt")
+	}
+
+	if p.ui == nil || !p.ui.Interactive() {
+		if req.Default != "" {
+			return req.Default, nil
+		}
+
+		return "", fmt.Errorf("cannot request input for %q: %w",
+			req.Prompt, terminal.ErrNonInteractive)
+	}
+
+	// Only allow a single prompt at a time
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	value, err := p.ui.Input(&terminal.Input{
+		Prompt: req.Prompt,
+		Style:  terminal.InfoStyle,
+		Secret: req.Secret,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if value == "" {
+		value = req.Default
+	}
+
+	return value, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+)
+
+type testInputUI struct {
+	terminal.UI
+	interactive bool
+	response    string
+	inputs      []*terminal.Input
+}
+
+func (u *testInputUI) Interactive() bool {
+	return u.interactive
+}
+
+func (u *testInputUI) Input(i *terminal.Input) (string, error) {
+	u.inputs = append(u.inputs, i)
+	return u.response, nil
+}
+
+func TestPrompterInput(t *testing.T) {
+	ui := &testInputUI{interactive: true, response: "value"}
+	p := NewPrompter(ui)
+
+	result, err := p.Input(&InputRequest{Prompt: "Name?", Secret: true})
+	require.NoError(t, err)
+	require.Equal(t, "value", result)
+	require.Len(t, ui.inputs, 1)
+	require.Equal(t, "Name?", ui.inputs[0].Prompt)
+	require.True(t, ui.inputs[0].Secret)
+
+	// Empty response uses the default
+	ui.response = ""
+	result, err = p.Input(&InputRequest{Prompt: "Name?", Default: "default"})
+	require.NoError(t, err)
+	require.Equal(t, "default", result)
+
+	_, err = p.Input(&InputRequest{})
+	require.Error(t, err)
+}
+
+func TestPrompterInputNonInteractive(t *testing.T) {
+	ui := &testInputUI{}
+	p := NewPrompter(ui)
+
+	result, err := p.Input(&InputRequest{Prompt: "Name?", Default: "default"})
+	require.NoError(t, err)
+	require.Equal(t, "default", result)
+
+	_, err = p.Input(&InputRequest{Prompt: "Name?"})
+	require.True(t, errors.Is(err, terminal.ErrNonInteractive))
+	require.Empty(t, ui.inputs)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// RunJob executes the operation defined by the job. Command
+// operations are run within the scope of the job (basis, project,
+// or target). A failed command is reported within the result
+// rather than as an error. Operations which are not supported
+// return an Unimplemented error.
+func (b *Basis) RunJob(
+	ctx context.Context, // context for the job
+	job *vagrant_server.Job, // job to execute
+) (*vagrant_server.Job_Result, error) {
+	if job == nil {
+		return nil, status.Error(codes.InvalidArgument, "job cannot be nil")
+	}
+
+	b.logger.Debug("running job",
+		"id", job.Id,
+		"operation", fmt.Sprintf("%T", job.Operation),
+	)
+
+	switch op := job.Operation.(type) {
+	case *vagrant_server.Job_Noop_:
+		return &vagrant_server.Job_Result{}, nil
+
+	case *vagrant_server.Job_Init:
+		r, err := b.RunInit()
+		if err != nil {
+			return nil, err
+		}
+
+		return &vagrant_server.Job_Result{Init: r}, nil
+
+	case *vagrant_server.Job_InitBasis:
+		return &vagrant_server.Job_Result{
+			Basis: &vagrant_server.Job_InitBasisResult{
+				Basis: b.Ref().(*vagrant_plugin_sdk.Ref_Basis),
+			},
+		}, nil
+
+	case *vagrant_server.Job_InitProject:
+		p, err := b.jobProject(job)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			return nil, status.Error(codes.InvalidArgument,
+				"project init requires a project scoped job")
+		}
+
+		return &vagrant_server.Job_Result{
+			Project: &vagrant_server.Job_InitProjectResult{
+				Project: p.Ref().(*vagrant_plugin_sdk.Ref_Project),
+			},
+		}, nil
+
+	case *vagrant_server.Job_Command:
+		run, err := b.jobRunner(job)
+		if err != nil {
+			return nil, err
+		}
+
+		warnings, err := run(ctx, op.Command)
+		if len(warnings) > 0 {
+			b.logger.Warn("job command reported warnings",
+				"id", job.Id,
+				"warnings", warnings,
+			)
+		}
+
+		return &vagrant_server.Job_Result{
+			Run: jobCommandResult(err),
+		}, nil
+
+	default:
+		return nil, status.Errorf(codes.Unimplemented,
+			"unsupported job operation %T", job.Operation)
+	}
+}
+
+// Load the project the job is scoped to. If the job is
+// scoped to the basis, nil is returned.
+func (b *Basis) jobProject(job *vagrant_server.Job) (*Project, error) {
+	var ref *vagrant_plugin_sdk.Ref_Project
+	switch s := job.Scope.(type) {
+	case nil, *vagrant_server.Job_Basis:
+		return nil, nil
+	case *vagrant_server.Job_Project:
+		ref = s.Project
+	case *vagrant_server.Job_Target:
+		ref = s.Target.Project
+	default:
+		return nil, fmt.Errorf("invalid job scope %T", job.Scope)
+	}
+
+	return b.factory.NewProject(
+		WithBasis(b),
+		WithProjectRef(ref),
+	)
+}
+
+// Provides the run function for the scope of the job
+func (b *Basis) jobRunner(
+	job *vagrant_server.Job,
+) (func(context.Context, *vagrant_server.Job_CommandOp) ([]string, error), error) {
+	p, err := b.jobProject(job)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return b.Run, nil
+	}
+
+	s, ok := job.Scope.(*vagrant_server.Job_Target)
+	if !ok {
+		return p.Run, nil
+	}
+
+	t, err := b.factory.NewTarget(
+		WithProject(p),
+		WithTargetRef(s.Target),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Run, nil
+}
+
+// Build the command result for the error returned from a command
+func jobCommandResult(err error) *vagrant_server.Job_CommandResult {
+	result := &vagrant_server.Job_CommandResult{
+		RunResult: err == nil,
+	}
+	if err == nil {
+		return result
+	}
+
+	if cmdErr, ok := err.(CommandError); ok {
+		result.RunError = cmdErr.Status()
+		result.ExitCode = cmdErr.ExitCode()
+	} else {
+		result.RunError = status.Newf(codes.Unknown,
+			"Unexpected error from run operation: %s", err).Proto()
+		result.ExitCode = 1
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestBasisRunJob(t *testing.T) {
+	b := TestBasis(t)
+
+	_, err := b.RunJob(b.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	result, err := b.RunJob(b.ctx, &vagrant_server.Job{
+		Operation: &vagrant_server.Job_Noop_{Noop: &vagrant_server.Job_Noop{}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	result, err = b.RunJob(b.ctx, &vagrant_server.Job{
+		Operation: &vagrant_server.Job_InitBasis{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, b.basis.ResourceId, result.Basis.Basis.ResourceId)
+
+	_, err = b.RunJob(b.ctx, &vagrant_server.Job{
+		Operation: &vagrant_server.Job_InitProject{},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	result, err = b.RunJob(b.ctx, &vagrant_server.Job{
+		Scope: &vagrant_server.Job_Project{
+			Project: &vagrant_plugin_sdk.Ref_Project{
+				Name: "job-project",
+				Path: t.TempDir(),
+			},
+		},
+		Operation: &vagrant_server.Job_InitProject{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "job-project", result.Project.Project.Name)
+
+	_, err = b.RunJob(b.ctx, &vagrant_server.Job{
+		Operation: &vagrant_server.Job_Docs{},
+	})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestJobCommandResult(t *testing.T) {
+	result := jobCommandResult(nil)
+	require.True(t, result.RunResult)
+	require.Nil(t, result.RunError)
+
+	result = jobCommandResult(&runError{
+		err:      errors.New("failed"),
+		exitCode: 3,
+		status:   status.New(codes.Internal, "failed").Proto(),
+	})
+	require.False(t, result.RunResult)
+	require.Equal(t, int32(3), result.ExitCode)
+	require.Equal(t, int32(codes.Internal), result.RunError.Code)
+
+	result = jobCommandResult(errors.New("unexpected"))
+	require.False(t, result.RunResult)
+	require.Equal(t, int32(1), result.ExitCode)
+	require.Equal(t, int32(codes.Unknown), result.RunError.Code)
+}
@@ -4,25 +4,258 @@
 package core
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/dynamic"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/protomappers"
 	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hashicorp/vagrant/internal/plugin/commandargs"
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
 )
 
 var Mappers = []interface{}{
 	JobCommandProto,
+	JobCommandFlagsProto,
+	CommandArgumentsProto,
+	CommandArgToMap,
+	ResolvedConfigProto,
+	commandargs.ArtifactsProto,
+	commandargs.CancelCleanupProto,
+	commandargs.CredentialsProto,
+	commandargs.InvokerProto,
+	commandargs.ProgressProto,
+	commandargs.PrompterProto,
+	commandargs.StdinProto,
+	commandargs.WarningsProto,
+}
+
+// Compiled mappers shared by all bases. Compiling the mappers is
+// expensive so it is only done once. The compiled functions are not
+// modified when called so they can be safely shared.
+var compiledMappers struct {
+	defaults []*argmapper.Func // compiled protomappers.All
+	locals   []*argmapper.Func // compiled local Mappers
+	err      error
+
+	once sync.Once
+}
+
+// Returns the compiled default and local mappers. The returned
+// slices are shared and must not be modified.
+func defaultMappers() (defaults, locals []*argmapper.Func, err error) {
+	c := &compiledMappers
+	c.once.Do(func() {
+		c.defaults, c.err = argmapper.NewFuncList(protomappers.All,
+			argmapper.Logger(dynamic.Logger),
+		)
+		if c.err != nil {
+			return
+		}
+		c.locals, c.err = argmapper.NewFuncList(Mappers, argmapper.Logger(dynamic.Logger))
+	})
+
+	return c.defaults, c.locals, c.err
+}
+
+// CommandFlags are command flag values converted to the
+// Go type of the flag
+type CommandFlags map[string]interface{}
+
+// Flag types for values the SDK does not define. The CLI provides
+// these flags as string flags and CommandArgToMap parses the value
+// into the Go type of the flag.
+const (
+	FlagInt         component.FlagType = component.FlagBool << (iota + 1) // Int
+	FlagDuration                                                          // Duration
+	FlagStringSlice                                                       // StringSlice
+)
+
+// FlagRequired is combined with the type of a flag which must be
+// provided, like component.FlagString | FlagRequired. The SDK does
+// not define required flags so they are provided by
+// JobCommandFlagsProto.
+const FlagRequired component.FlagType = 1 << 8
+
+// Type of the flag without FlagRequired
+func commandFlagType(f *component.CommandFlag) component.FlagType {
+	return f.Type &^ FlagRequired
+}
+
+// CommandArgumentsProto converts structured command parameters into
+// the arguments proto provided to commands. Flags are sorted by name
+// and must be bool, string, int, time.Duration or []string values.
+// Values which are not bool or string are provided as strings.
+func CommandArgumentsProto(c *component.CommandParams) (*vagrant_plugin_sdk.Command_Arguments, error) {
+	names := make([]string, 0, len(c.Flags))
+	for k := range c.Flags {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	flags := make([]*vagrant_plugin_sdk.Command_Arguments_Flag, 0, len(names))
+	for _, name := range names {
+		f := &vagrant_plugin_sdk.Command_Arguments_Flag{Name: name}
+		switch v := c.Flags[name].(type) {
+		case bool:
+			f.Type = vagrant_plugin_sdk.Command_Arguments_Flag_BOOL
+			f.Value = &vagrant_plugin_sdk.Command_Arguments_Flag_Bool{Bool: v}
+		case string:
+			f.Type = vagrant_plugin_sdk.Command_Arguments_Flag_STRING
+			f.Value = &vagrant_plugin_sdk.Command_Arguments_Flag_String_{String_: v}
+		case int:
+			f.Type = vagrant_plugin_sdk.Command_Arguments_Flag_STRING
+			f.Value = &vagrant_plugin_sdk.Command_Arguments_Flag_String_{String_: strconv.Itoa(v)}
+		case time.Duration:
+			f.Type = vagrant_plugin_sdk.Command_Arguments_Flag_STRING
+			f.Value = &vagrant_plugin_sdk.Command_Arguments_Flag_String_{String_: v.String()}
+		case []string:
+			f.Type = vagrant_plugin_sdk.Command_Arguments_Flag_STRING
+			f.Value = &vagrant_plugin_sdk.Command_Arguments_Flag_String_{String_: strings.Join(v, ",")}
+		default:
+			return nil, fmt.Errorf("unsupported value type %T for flag %q", v, name)
+		}
+		flags = append(flags, f)
+	}
+
+	return &vagrant_plugin_sdk.Command_Arguments{
+		Args:  append([]string{}, c.Arguments...),
+		Flags: flags,
+	}, nil
+}
+
+// CommandArgToMap converts the command arguments into flag values
+// typed using the flags of the command. Bool flags are provided as
+// bool values, FlagInt as int, FlagDuration as time.Duration,
+// FlagStringSlice as []string from a comma separated value, and
+// string flags as string values. Flags which were not set are
+// provided using their default value. An error is returned if a
+// flag is not defined by the command, a required flag is not set,
+// or a value cannot be parsed.
+func CommandArgToMap(
+	info *component.CommandInfo, // command the arguments are for
+	args *vagrant_plugin_sdk.Command_Arguments, // arguments to convert
+) (CommandFlags, error) {
+	flags := map[string]*component.CommandFlag{}
+	for _, f := range info.Flags {
+		flags[f.LongName] = f
+		for _, a := range f.Aliases {
+			flags[a] = f
+		}
+	}
+
+	values := map[string]string{}
+	for _, f := range info.Flags {
+		values[f.LongName] = f.DefaultValue
+	}
+	set := map[string]bool{}
+	for _, af := range args.Flags {
+		f, ok := flags[af.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown flag %q for command %q", af.Name, info.Name)
+		}
+		set[f.LongName] = true
+		switch v := af.Value.(type) {
+		case *vagrant_plugin_sdk.Command_Arguments_Flag_Bool:
+			values[f.LongName] = strconv.FormatBool(v.Bool)
+		case *vagrant_plugin_sdk.Command_Arguments_Flag_String_:
+			values[f.LongName] = v.String_
+		}
+	}
+
+	result := CommandFlags{}
+	for _, f := range info.Flags {
+		if f.Type&FlagRequired != 0 && !set[f.LongName] {
+			return nil, fmt.Errorf("flag %q is required for command %q",
+				f.LongName, info.Name)
+		}
+		v, err := commandFlagValue(f, values[f.LongName])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for flag %q of command %q: %w",
+				f.LongName, info.Name, err)
+		}
+		result[f.LongName] = v
+	}
+
+	return result, nil
+}
+
+// Convert the flag value to the Go type of the flag
+func commandFlagValue(f *component.CommandFlag, v string) (interface{}, error) {
+	switch commandFlagType(f) {
+	case component.FlagBool:
+		if v == "" {
+			return false, nil
+		}
+		return strconv.ParseBool(v)
+	case FlagInt:
+		if v == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(v)
+	case FlagDuration:
+		if v == "" {
+			return time.Duration(0), nil
+		}
+		return time.ParseDuration(v)
+	case FlagStringSlice:
+		if v == "" {
+			return []string{}, nil
+		}
+		return strings.Split(v, ","), nil
+	}
+
+	return v, nil
+}
+
+// Flags with a type the SDK does not define are converted
+// to string flags and FlagRequired is removed
+func sdkCommandFlags(flags []*component.CommandFlag) []*component.CommandFlag {
+	result := make([]*component.CommandFlag, len(flags))
+	for i, f := range flags {
+		typ := commandFlagType(f)
+		switch typ {
+		case FlagInt, FlagDuration, FlagStringSlice:
+			typ = component.FlagString
+		}
+		if typ != f.Type {
+			sf := *f
+			sf.Type = typ
+			f = &sf
+		}
+		result[i] = f
+	}
+
+	return result
 }
 
-// JobCommandProto converts a CommandInfo into its proto equivalent
-func JobCommandProto(c *component.CommandInfo) []*vagrant_plugin_sdk.Command_CommandInfo {
+// JobCommandProto converts a CommandInfo into its proto equivalent.
+// Flag types and default values are retained so the CLI can validate
+// input. Flags with a type the SDK does not define are provided as
+// string flags. Required flags are provided by JobCommandFlagsProto
+// since the Command_Flag proto does not define them. An error is
+// returned if a flag has an unsupported type.
+func JobCommandProto(c *component.CommandInfo) ([]*vagrant_plugin_sdk.Command_CommandInfo, error) {
 	return jobCommandProto(c, []string{})
 }
 
-func jobCommandProto(c *component.CommandInfo, names []string) []*vagrant_plugin_sdk.Command_CommandInfo {
+func jobCommandProto(c *component.CommandInfo, names []string) ([]*vagrant_plugin_sdk.Command_CommandInfo, error) {
 	names = append(names, c.Name)
-	flgs, _ := protomappers.FlagsProto(c.Flags)
+	flgs, err := protomappers.FlagsProto(sdkCommandFlags(c.Flags))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert flags for command %q: %w",
+			strings.Join(names, " "), err)
+	}
+
 	cmds := []*vagrant_plugin_sdk.Command_CommandInfo{
 		{
 			Name:     strings.Join(names, " "),
@@ -33,7 +266,124 @@ func jobCommandProto(c *component.CommandInfo, names []string) []*vagrant_plugin
 	}
 
 	for _, scmd := range c.Subcommands {
-		cmds = append(cmds, jobCommandProto(scmd, names)...)
+		scmds, err := jobCommandProto(scmd, names)
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, scmds...)
+	}
+	return cmds, nil
+}
+
+// JobCommandFlagsProto provides the required flags of a command
+// and its subcommands. The command names match the names provided
+// by JobCommandProto.
+func JobCommandFlagsProto(c *component.CommandInfo) ([]*vagrant_server.Job_CommandFlags, error) {
+	return jobCommandFlagsProto(c, []string{}), nil
+}
+
+func jobCommandFlagsProto(c *component.CommandInfo, names []string) []*vagrant_server.Job_CommandFlags {
+	names = append(names, c.Name)
+	flags := &vagrant_server.Job_CommandFlags{
+		Command:  strings.Join(names, " "),
+		Required: []string{},
+	}
+	for _, f := range c.Flags {
+		if f.Type&FlagRequired != 0 {
+			flags.Required = append(flags.Required, f.LongName)
+		}
+	}
+
+	result := []*vagrant_server.Job_CommandFlags{flags}
+	for _, scmd := range c.Subcommands {
+		result = append(result, jobCommandFlagsProto(scmd, names)...)
 	}
-	return cmds
+
+	return result
+}
+
+// ResolvedConfigProto converts the resolved configuration so it can
+// be provided to plugins. Component configuration values which are
+// invalid are provided as an error for the component.
+func ResolvedConfigProto(r *ResolvedConfig) (*vagrant_command.ResolvedConfig, error) {
+	result := &vagrant_command.ResolvedConfig{
+		RunnerEnabled: r.RunnerEnabled(),
+		Labels:        r.Labels(),
+	}
+
+	if p := r.PluginPolicy(); p != nil {
+		result.PluginPolicy = &vagrant_command.ResolvedConfig_PluginPolicy{
+			Allow: p.Allow,
+			Deny:  p.Deny,
+		}
+	}
+
+	for _, c := range r.components {
+		comp := &vagrant_command.ResolvedConfig_Component{
+			Type: c.Type,
+			Name: c.Name,
+		}
+		result.Components = append(result.Components, comp)
+
+		values, err := c.Values()
+		if err == nil {
+			comp.Values, err = structpb.NewStruct(values)
+		}
+		if err != nil {
+			comp.Error = err.Error()
+		}
+	}
+
+	return result, nil
+}
+
+// Sources of mappers registered with a basis
+const (
+	MapperSourceBase   = "base"   // default mapper set, or the set provided by WithMapperSet
+	MapperSourceLocal  = "local"  // local command mappers
+	MapperSourceOption = "option" // mappers added with WithMappers
+)
+
+// MapperInfo describes a mapper registered with a basis
+type MapperInfo struct {
+	Name    string   // name of the mapper function
+	Source  string   // where the mapper was registered from
+	Inputs  []string // values the mapper requires
+	Outputs []string // values the mapper produces
+}
+
+// String provides the mapper as "name: inputs -> outputs"
+func (m *MapperInfo) String() string {
+	return fmt.Sprintf("%s: %s -> %s", m.Name,
+		strings.Join(m.Inputs, ", "), strings.Join(m.Outputs, ", "))
+}
+
+// Mappers describes each mapper used by the basis for dynamic
+// function calls in the order they are registered. This includes
+// the base mapper set, the local command mappers, and any mappers
+// added with WithMappers. It is useful for determining why no
+// conversion path exists for an argument.
+func (b *Basis) Mappers() []*MapperInfo {
+	result := []*MapperInfo{}
+	add := func(source string, mappers []*argmapper.Func) {
+		for _, m := range mappers {
+			result = append(result, &MapperInfo{
+				Name:    m.Name(),
+				Source:  source,
+				Inputs:  mapperValues(m.Input()),
+				Outputs: mapperValues(m.Output()),
+			})
+		}
+	}
+
+	// The base and local mappers are only included once
+	// the basis is initialized
+	if b.ready {
+		_, locals, _ := defaultMappers()
+		add(MapperSourceBase, b.mapperSet)
+		add(MapperSourceLocal, locals)
+	}
+	add(MapperSourceOption, b.optionMappers)
+
+	return result
 }
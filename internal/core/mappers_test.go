@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/protomappers"
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+func TestJobCommandProtoFlags(t *testing.T) {
+	tests := []struct {
+		flag  *component.CommandFlag
+		ptype vagrant_plugin_sdk.Command_Flag_Type
+	}{
+		{
+			flag: &component.CommandFlag{
+				LongName:     "provider",
+				ShortName:    "p",
+				Description:  "provider to use",
+				DefaultValue: "virtualbox",
+				Type:         component.FlagString,
+				Aliases:      []string{"prov"},
+			},
+			ptype: vagrant_plugin_sdk.Command_Flag_STRING,
+		},
+		{
+			flag: &component.CommandFlag{
+				LongName:     "force",
+				ShortName:    "f",
+				Description:  "force the action",
+				DefaultValue: "false",
+				Type:         component.FlagBool,
+			},
+			ptype: vagrant_plugin_sdk.Command_Flag_BOOL,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.flag.LongName, func(t *testing.T) {
+			cmds, err := JobCommandProto(&component.CommandInfo{
+				Name:  "test",
+				Flags: []*component.CommandFlag{tc.flag},
+			})
+			require.NoError(t, err)
+			require.Len(t, cmds, 1)
+			require.Len(t, cmds[0].Flags, 1)
+
+			pflag := cmds[0].Flags[0]
+			require.Equal(t, tc.ptype, pflag.Type)
+			require.Equal(t, tc.flag.DefaultValue, pflag.DefaultValue)
+
+			flags, err := protomappers.Flags(cmds[0].Flags)
+			require.NoError(t, err)
+			require.Equal(t, []*component.CommandFlag{tc.flag}, flags)
+		})
+	}
+}
+
+func TestJobCommandProtoTypedFlags(t *testing.T) {
+	for _, typ := range []component.FlagType{FlagInt, FlagDuration, FlagStringSlice} {
+		flag := &component.CommandFlag{LongName: "value", DefaultValue: "1", Type: typ}
+		cmds, err := JobCommandProto(&component.CommandInfo{
+			Name:  "test",
+			Flags: []*component.CommandFlag{flag},
+		})
+		require.NoError(t, err)
+		require.Equal(t, vagrant_plugin_sdk.Command_Flag_STRING, cmds[0].Flags[0].Type)
+		require.Equal(t, "1", cmds[0].Flags[0].DefaultValue)
+
+		// The original flag is not modified
+		require.Equal(t, typ, flag.Type)
+	}
+}
+
+func TestJobCommandProtoInvalidFlag(t *testing.T) {
+	_, err := JobCommandProto(&component.CommandInfo{
+		Name: "test",
+		Subcommands: []*component.CommandInfo{
+			{
+				Name: "sub",
+				Flags: []*component.CommandFlag{
+					{LongName: "bad", Type: component.FlagType(0)},
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "test sub")
+}
+
+func TestJobCommandFlagsProto(t *testing.T) {
+	info := &component.CommandInfo{
+		Name: "snapshot",
+		Flags: []*component.CommandFlag{
+			{LongName: "force", Type: component.FlagBool},
+		},
+		Subcommands: []*component.CommandInfo{
+			{
+				Name: "save",
+				Flags: []*component.CommandFlag{
+					{LongName: "name", Type: component.FlagString | FlagRequired},
+					{LongName: "count", Type: FlagInt | FlagRequired},
+					{LongName: "force", Type: component.FlagBool},
+				},
+			},
+		},
+	}
+
+	flags, err := JobCommandFlagsProto(info)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+	require.Equal(t, "snapshot", flags[0].Command)
+	require.Empty(t, flags[0].Required)
+	require.Equal(t, "snapshot save", flags[1].Command)
+	require.Equal(t, []string{"name", "count"}, flags[1].Required)
+
+	// The command info proto only includes the type of the flag
+	cmds, err := JobCommandProto(info)
+	require.NoError(t, err)
+	require.Equal(t, flags[1].Command, cmds[1].Name)
+	require.Equal(t, vagrant_plugin_sdk.Command_Flag_STRING, cmds[1].Flags[0].Type)
+	require.Equal(t, vagrant_plugin_sdk.Command_Flag_STRING, cmds[1].Flags[1].Type)
+
+	// Required flags are provided through the mappers
+	b := TestBasis(t)
+	raw, err := b.callDynamicFunc(context.Background(), b.logger,
+		func(f []*vagrant_server.Job_CommandFlags) []*vagrant_server.Job_CommandFlags {
+			return f
+		},
+		(*[]*vagrant_server.Job_CommandFlags)(nil),
+		argmapper.Typed(info),
+	)
+	require.NoError(t, err)
+	mapped := raw.([]*vagrant_server.Job_CommandFlags)
+	require.Len(t, mapped, 2)
+	require.Equal(t, flags[1].Required, mapped[1].Required)
+}
+
+func TestCommandArgumentsProto(t *testing.T) {
+	params := &component.CommandParams{
+		Arguments: []string{"default"},
+		Flags: map[string]interface{}{
+			"provider": "virtualbox",
+			"force":    true,
+		},
+	}
+
+	args, err := CommandArgumentsProto(params)
+	require.NoError(t, err)
+	require.Equal(t, []string{"default"}, args.Args)
+	require.Len(t, args.Flags, 2)
+	require.Equal(t, "force", args.Flags[0].Name)
+
+	// Converting back should provide the original parameters
+	require.Equal(t, params, protomappers.CommandParams(args))
+
+	_, err = CommandArgumentsProto(&component.CommandParams{
+		Flags: map[string]interface{}{"ratio": 0.5},
+	})
+	require.Error(t, err)
+}
+
+func TestCommandArgToMap(t *testing.T) {
+	info := &component.CommandInfo{
+		Name: "up",
+		Flags: []*component.CommandFlag{
+			{LongName: "force", Type: component.FlagBool, DefaultValue: "false"},
+			{LongName: "count", Type: FlagInt, DefaultValue: "1"},
+			{LongName: "timeout", Type: FlagDuration, DefaultValue: "30s"},
+			{LongName: "only", Type: FlagStringSlice, DefaultValue: "a,b", Aliases: []string{"o"}},
+			{LongName: "provider", Type: component.FlagString},
+			{LongName: "name", Type: component.FlagString, DefaultValue: "1"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		result interface{}
+	}{
+		{name: "force", value: true, result: true},
+		{name: "force", value: false, result: false},
+		{name: "count", value: 3, result: 3},
+		{name: "count", value: "-2", result: -2},
+		{name: "timeout", value: 2 * time.Minute, result: 2 * time.Minute},
+		{name: "timeout", value: "1h30m", result: 90 * time.Minute},
+		{name: "only", value: []string{"web", "db"}, result: []string{"web", "db"}},
+		{name: "only", value: []string{"web"}, result: []string{"web"}},
+		{name: "provider", value: "docker", result: "docker"},
+		{name: "name", value: "a,b", result: "a,b"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s=%v", tc.name, tc.value), func(t *testing.T) {
+			args, err := CommandArgumentsProto(&component.CommandParams{
+				Flags: map[string]interface{}{tc.name: tc.value},
+			})
+			require.NoError(t, err)
+
+			flags, err := CommandArgToMap(info, args)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, flags[tc.name])
+		})
+	}
+}
+
+func TestCommandArgToMapDefaults(t *testing.T) {
+	info := &component.CommandInfo{
+		Name: "up",
+		Flags: []*component.CommandFlag{
+			{LongName: "force", Type: component.FlagBool},
+			{LongName: "count", Type: FlagInt, DefaultValue: "1"},
+			{LongName: "retries", Type: FlagInt},
+			{LongName: "timeout", Type: FlagDuration, DefaultValue: "30s"},
+			{LongName: "wait", Type: FlagDuration},
+			{LongName: "only", Type: FlagStringSlice, DefaultValue: "a,b", Aliases: []string{"o"}},
+			{LongName: "except", Type: FlagStringSlice},
+			{LongName: "name", Type: component.FlagString, DefaultValue: "1"},
+		},
+	}
+
+	flags, err := CommandArgToMap(info, &vagrant_plugin_sdk.Command_Arguments{
+		Flags: []*vagrant_plugin_sdk.Command_Arguments_Flag{
+			{
+				Name:  "o",
+				Type:  vagrant_plugin_sdk.Command_Arguments_Flag_STRING,
+				Value: &vagrant_plugin_sdk.Command_Arguments_Flag_String_{String_: "web"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, CommandFlags{
+		"force":   false,
+		"count":   1,
+		"retries": 0,
+		"timeout": 30 * time.Second,
+		"wait":    time.Duration(0),
+		"only":    []string{"web"},
+		"except":  []string{},
+		"name":    "1",
+	}, flags)
+}
+
+func TestCommandArgToMapErrors(t *testing.T) {
+	info := &component.CommandInfo{
+		Name: "up",
+		Flags: []*component.CommandFlag{
+			{LongName: "force", Type: component.FlagBool},
+			{LongName: "count", Type: FlagInt},
+			{LongName: "timeout", Type: FlagDuration},
+		},
+	}
+
+	args, err := CommandArgumentsProto(&component.CommandParams{
+		Flags: map[string]interface{}{"unknown": true},
+	})
+	require.NoError(t, err)
+	_, err = CommandArgToMap(info, args)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unknown flag "unknown" for command "up"`)
+
+	t.Run("required", func(t *testing.T) {
+		info := &component.CommandInfo{
+			Name: "up",
+			Flags: []*component.CommandFlag{
+				{LongName: "count", Type: FlagInt | FlagRequired, DefaultValue: "1"},
+			},
+		}
+		_, err := CommandArgToMap(info, &vagrant_plugin_sdk.Command_Arguments{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `flag "count" is required for command "up"`)
+
+		args, err := CommandArgumentsProto(&component.CommandParams{
+			Flags: map[string]interface{}{"count": 3},
+		})
+		require.NoError(t, err)
+		flags, err := CommandArgToMap(info, args)
+		require.NoError(t, err)
+		require.Equal(t, CommandFlags{"count": 3}, flags)
+	})
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "force", value: "maybe"},
+		{name: "count", value: "many"},
+		{name: "timeout", value: "soon"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := CommandArgumentsProto(&component.CommandParams{
+				Flags: map[string]interface{}{tc.name: tc.value},
+			})
+			require.NoError(t, err)
+			_, err = CommandArgToMap(info, args)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), fmt.Sprintf("%q of command \"up\"", tc.name))
+		})
+	}
+}
+
+func TestDefaultMappersShared(t *testing.T) {
+	custom, err := argmapper.NewFunc(func(int8) int16 { return 0 })
+	require.NoError(t, err)
+
+	first := TestBasis(t, WithMappers(custom))
+	second := TestBasis(t)
+
+	defaults, locals, err := defaultMappers()
+	require.NoError(t, err)
+	count := len(defaults) + len(locals)
+
+	// The compiled default mappers are reused
+	require.Same(t, first.mappers[0], second.mappers[0])
+	require.Same(t, defaults[0], first.mappers[0])
+
+	// Custom mappers are only added to the basis they were provided to
+	require.Len(t, first.mappers, count+1)
+	require.Same(t, custom, first.mappers[count])
+	require.Len(t, second.mappers, count)
+}
+
+func TestBasisMappers(t *testing.T) {
+	custom, err := argmapper.NewFunc(func(int8) int16 { return 0 })
+	require.NoError(t, err)
+
+	b := TestBasis(t, WithMappers(custom))
+	defaults, locals, err := defaultMappers()
+	require.NoError(t, err)
+
+	mappers := b.Mappers()
+	require.Len(t, mappers, len(defaults)+len(locals)+1)
+	require.Equal(t, MapperSourceBase, mappers[0].Source)
+	require.Equal(t, defaults[0].Name(), mappers[0].Name)
+	require.Equal(t, MapperSourceLocal, mappers[len(defaults)].Source)
+	require.Equal(t, MapperSourceLocal, mappers[len(defaults)+len(locals)-1].Source)
+
+	// Mappers added with options describe their conversion
+	added := mappers[len(mappers)-1]
+	require.Equal(t, MapperSourceOption, added.Source)
+	require.Equal(t, []string{"type: int8"}, added.Inputs)
+	require.Equal(t, []string{"type: int16"}, added.Outputs)
+	require.Contains(t, added.String(), ": type: int8 -> type: int16")
+}
@@ -27,6 +27,8 @@ type scope interface {
 	JobInfo() *component.JobInfo
 	Client() *serverclient.VagrantClient
 	execHook(ctx context.Context, log hclog.Logger, h *config.Hook) (err error)
+	retryPolicy(operation) *operationRetry
+	lifecycle() *eventStream
 }
 
 // operation is a private interface that we implement for "operations" such
@@ -133,7 +135,13 @@ func doOperation(
 	var result interface{}
 	if doErr == nil {
 		log.Debug("running local operation")
-		result, doErr = op.Do(ctx, log, s, msg)
+		ui, _ := s.UI()
+		name := operationName(op)
+		s.lifecycle().emit(EventOperationStarted, name, "", nil)
+		result, doErr = s.retryPolicy(op).do(ctx, log, ui, func() (interface{}, error) {
+			return op.Do(ctx, log, s, msg)
+		})
+		s.lifecycle().emit(EventOperationFinished, name, "", doErr)
 		if doErr == nil {
 			// No error, our state is success
 			server.StatusSetSuccess(*statusPtr)
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type forceOperationKey struct{}
+
+// ForceOperation returns a context which runs operations even if
+// an operation with the same idempotency key has already completed.
+func ForceOperation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceOperationKey{}, true)
+}
+
+// Check if the context requests operations be forced
+func operationForced(ctx context.Context) bool {
+	forced, _ := ctx.Value(forceOperationKey{}).(bool)
+	return forced
+}
+
+// Build the idempotency key for an operation run within a
+// scope. The key is built from the id of the job running the
+// operation, so only operations run as part of a job have a
+// key. If no key is available an empty string is returned.
+func idempotencyKey(s scope, op operation) string {
+	info := s.JobInfo()
+	if info == nil || info.Id == "" {
+		return ""
+	}
+	ref, ok := s.Ref().(interface{ GetResourceId() string })
+	if !ok {
+		return ""
+	}
+
+	return info.Id + "/" + ref.GetResourceId() + "/" + operationName(op)
+}
+
+// Result of an operation recorded by its idempotency key
+type idempotentResult struct {
+	done    chan struct{} // closed when the operation completes
+	expires time.Time     // time the result is no longer used
+	result  interface{}   // result of the operation
+	msg     proto.Message // operation metadata
+	err     error         // error returned by the operation
+}
+
+// idempotencyCache records the results of operations by their
+// idempotency key so an operation dispatched more than once is
+// only executed once. Results are kept until the TTL expires.
+// Failed operations are not recorded so they can be retried.
+type idempotencyCache struct {
+	entries map[string]*idempotentResult
+	ttl     time.Duration // time results are kept, disabled if zero
+
+	m sync.Mutex
+}
+
+func newIdempotencyCache(ttl time.Duration) *idempotencyCache {
+	return &idempotencyCache{
+		entries: map[string]*idempotentResult{},
+		ttl:     ttl,
+	}
+}
+
+// Run the operation unless a result has already been recorded for
+// the key. If the operation with the same key is in flight, wait
+// for it to complete and return its result. The returned boolean
+// is true when a recorded result was returned.
+func (c *idempotencyCache) run(
+	ctx context.Context, // context for the operation
+	key string, // idempotency key of the operation
+	fn func() (interface{}, proto.Message, error), // runs the operation
+) (interface{}, proto.Message, bool, error) {
+	if c.ttl <= 0 || key == "" {
+		result, msg, err := fn()
+		return result, msg, false, err
+	}
+
+	c.m.Lock()
+	c.expire()
+	if e, ok := c.entries[key]; ok && !operationForced(ctx) {
+		c.m.Unlock()
+
+		select {
+		case <-e.done:
+			return e.result, e.msg, true, e.err
+		case <-ctx.Done():
+			return nil, nil, false, ctx.Err()
+		}
+	}
+	e := &idempotentResult{done: make(chan struct{})}
+	c.entries[key] = e
+	c.m.Unlock()
+
+	e.result, e.msg, e.err = fn()
+
+	c.m.Lock()
+	if e.err != nil && c.entries[key] == e {
+		delete(c.entries, key)
+	}
+	e.expires = time.Now().Add(c.ttl)
+	c.m.Unlock()
+	close(e.done)
+
+	return e.result, e.msg, false, e.err
+}
+
+// Remove expired results. Must be called with the lock held.
+func (c *idempotencyCache) expire() {
+	now := time.Now()
+	for k, e := range c.entries {
+		select {
+		case <-e.done:
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		default:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIdempotencyCache(t *testing.T) {
+	calls := 0
+	fn := func() (interface{}, proto.Message, error) {
+		calls++
+		return calls, nil, nil
+	}
+	c := newIdempotencyCache(time.Hour)
+	ctx := context.Background()
+
+	// Repeated operations return the recorded result
+	result, _, replayed, err := c.run(ctx, "job/target/up", fn)
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.Equal(t, 1, result)
+	result, _, replayed, err = c.run(ctx, "job/target/up", fn)
+	require.NoError(t, err)
+	require.True(t, replayed)
+	require.Equal(t, 1, result)
+
+	// Forced operations are run again
+	result, _, replayed, err = c.run(ForceOperation(ctx), "job/target/up", fn)
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.Equal(t, 2, result)
+
+	// Operations without a key are always run
+	result, _, _, err = c.run(ctx, "", fn)
+	require.NoError(t, err)
+	require.Equal(t, 3, result)
+
+	// Failed operations are not recorded
+	failed := errors.New("failed")
+	_, _, _, err = c.run(ctx, "job/target/halt", func() (interface{}, proto.Message, error) {
+		return nil, nil, failed
+	})
+	require.ErrorIs(t, err, failed)
+	result, _, replayed, err = c.run(ctx, "job/target/halt", fn)
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.Equal(t, 4, result)
+
+	// Results are not used once expired
+	c = newIdempotencyCache(time.Millisecond)
+	_, _, _, err = c.run(ctx, "job/target/up", fn)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, _, replayed, err = c.run(ctx, "job/target/up", fn)
+	require.NoError(t, err)
+	require.False(t, replayed)
+
+	// Results are not recorded when disabled
+	c = newIdempotencyCache(0)
+	_, _, _, err = c.run(ctx, "job/target/up", fn)
+	require.NoError(t, err)
+	_, _, replayed, err = c.run(ctx, "job/target/up", fn)
+	require.NoError(t, err)
+	require.False(t, replayed)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	b := TestBasis(t)
+	require.Empty(t, idempotencyKey(b, &testNamedOperation{}))
+
+	b = TestBasis(t, WithJobInfo(&component.JobInfo{Id: "job"}))
+	require.Equal(t, "job/"+b.basis.ResourceId+"/up", idempotencyKey(b, &testNamedOperation{}))
+
+	_, err := NewBasis(context.Background(), WithIdempotencyTTL(0))
+	require.Error(t, err)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/protobuf/proto"
+)
+
+// OperationFunc executes an operation
+type OperationFunc func(ctx context.Context, log hclog.Logger) (interface{}, proto.Message, error)
+
+// OperationMiddleware wraps the execution of an operation. The
+// middleware can run logic before and after calling next, or can
+// abort the operation by returning an error without calling next.
+type OperationMiddleware func(next OperationFunc) OperationFunc
+
+// TimingMiddleware logs the duration of every operation
+func TimingMiddleware() OperationMiddleware {
+	return func(next OperationFunc) OperationFunc {
+		return func(ctx context.Context, log hclog.Logger) (interface{}, proto.Message, error) {
+			start := time.Now()
+			result, msg, err := next(ctx, log)
+			log.Info("operation complete",
+				"duration", time.Since(start),
+				"success", err == nil,
+			)
+
+			return result, msg, err
+		}
+	}
+}
+
+// Wrap the operation function with the provided middleware. The
+// first middleware is the outermost. Any panic within a middleware
+// is recovered and returned as an error.
+func wrapOperation(
+	fn OperationFunc, // operation function to wrap
+	mw []OperationMiddleware, // middleware to apply
+) OperationFunc {
+	for i := len(mw) - 1; i >= 0; i-- {
+		fn = recoverOperation(mw[i](fn))
+	}
+
+	return fn
+}
+
+// Convert any panic raised while running the operation
+// function into an error
+func recoverOperation(fn OperationFunc) OperationFunc {
+	return func(ctx context.Context, log hclog.Logger) (result interface{}, msg proto.Message, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("panic during operation middleware",
+					"panic", r,
+					"stack", string(debug.Stack()),
+				)
+
+				result, msg = nil, nil
+				err = fmt.Errorf("operation middleware panic: %v", r)
+			}
+		}()
+
+		return fn(ctx, log)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWrapOperation(t *testing.T) {
+	order := []string{}
+	record := func(name string) OperationMiddleware {
+		return func(next OperationFunc) OperationFunc {
+			return func(ctx context.Context, log hclog.Logger) (interface{}, proto.Message, error) {
+				order = append(order, name+"-before")
+				r, m, err := next(ctx, log)
+				order = append(order, name+"-after")
+				return r, m, err
+			}
+		}
+	}
+
+	fn := wrapOperation(
+		func(context.Context, hclog.Logger) (interface{}, proto.Message, error) {
+			order = append(order, "op")
+			return "result", nil, nil
+		},
+		[]OperationMiddleware{record("outer"), record("inner"), TimingMiddleware()},
+	)
+
+	result, _, err := fn(context.Background(), hclog.NewNullLogger())
+	require.NoError(t, err)
+	require.Equal(t, "result", result)
+	require.Equal(t, []string{"outer-before", "inner-before", "op", "inner-after", "outer-after"}, order)
+}
+
+func TestWrapOperationAbortAndPanic(t *testing.T) {
+	called := false
+	op := func(context.Context, hclog.Logger) (interface{}, proto.Message, error) {
+		called = true
+		return nil, nil, nil
+	}
+
+	abort := func(OperationFunc) OperationFunc {
+		return func(context.Context, hclog.Logger) (interface{}, proto.Message, error) {
+			return nil, nil, errors.New("aborted")
+		}
+	}
+	_, _, err := wrapOperation(op, []OperationMiddleware{abort})(context.Background(), hclog.NewNullLogger())
+	require.EqualError(t, err, "aborted")
+	require.False(t, called)
+
+	panics := func(OperationFunc) OperationFunc {
+		return func(context.Context, hclog.Logger) (interface{}, proto.Message, error) {
+			panic("boom")
+		}
+	}
+	_, _, err = wrapOperation(op, []OperationMiddleware{panics})(context.Background(), hclog.NewNullLogger())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "boom")
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// Prefix used for operation ids when no job id is available
+const localOperationPrefix = "local"
+
+// OperationInfo describes an operation which is in flight
+type OperationInfo struct {
+	ID      string    // identifier used to cancel the operation
+	JobID   string    // id of the job running the operation
+	Name    string    // command or operation name
+	Started time.Time // time the operation started
+}
+
+// Operation which is currently running
+type inFlightOperation struct {
+	info   OperationInfo
+	cancel context.CancelFunc
+}
+
+// operationRegistry tracks in-flight operations so they
+// can be listed and cancelled individually
+type operationRegistry struct {
+	ops map[string]*inFlightOperation
+	seq uint64
+
+	m sync.Mutex
+}
+
+func newOperationRegistry() *operationRegistry {
+	return &operationRegistry{
+		ops: map[string]*inFlightOperation{},
+	}
+}
+
+// Register an in-flight operation. The returned context is cancelled
+// when the operation is cancelled. The returned function must be
+// called when the operation is complete.
+func (r *operationRegistry) track(
+	ctx context.Context, // context for the operation
+	job *component.JobInfo, // job running the operation
+	name string, // name of the operation
+) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	var jobID string
+	if job != nil {
+		jobID = job.Id
+	}
+
+	// The job id is used as the operation id. If the job is
+	// running multiple operations, or no job id is available,
+	// a sequence number is included to keep the id unique.
+	id := jobID
+	if id == "" {
+		id = localOperationPrefix
+	}
+	if _, exists := r.ops[id]; exists || jobID == "" {
+		r.seq++
+		id = fmt.Sprintf("%s-%d", id, r.seq)
+	}
+
+	r.ops[id] = &inFlightOperation{
+		info: OperationInfo{
+			ID:      id,
+			JobID:   jobID,
+			Name:    name,
+			Started: time.Now(),
+		},
+		cancel: cancel,
+	}
+
+	return ctx, func() {
+		r.m.Lock()
+		defer r.m.Unlock()
+
+		delete(r.ops, id)
+		cancel()
+	}
+}
+
+// Cancel the operation with the given id
+func (r *operationRegistry) cancel(id string) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	op, ok := r.ops[id]
+	if !ok {
+		return &OperationNotFoundError{ID: id}
+	}
+	op.cancel()
+
+	return nil
+}
+
+// List of in-flight operations ordered by start time
+func (r *operationRegistry) list() []OperationInfo {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	result := make([]OperationInfo, 0, len(r.ops))
+	for _, op := range r.ops {
+		result = append(result, op.info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Started.Equal(result[j].Started) {
+			return result[i].ID < result[j].ID
+		}
+		return result[i].Started.Before(result[j].Started)
+	})
+
+	return result
+}
+
+// CancelOperation cancels the in-flight operation with the given
+// id. If the operation is not in flight an OperationNotFoundError
+// is returned.
+func (b *Basis) CancelOperation(id string) error {
+	if err := b.operations.cancel(id); err != nil {
+		return err
+	}
+
+	b.logger.Info("cancelled in-flight operation",
+		"operation", id,
+	)
+
+	return nil
+}
+
+// InFlightOperations returns the commands and operations currently
+// running within the basis and its projects and targets
+func (b *Basis) InFlightOperations() []OperationInfo {
+	return b.operations.list()
+}
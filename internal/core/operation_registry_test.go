@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisCancelOperation(t *testing.T) {
+	b := TestBasis(t)
+	job := &component.JobInfo{Id: "job-1"}
+
+	ctx1, done1 := b.operations.track(context.Background(), job, "up")
+	defer done1()
+	ctx2, done2 := b.operations.track(context.Background(), job, "provision")
+	defer done2()
+
+	ops := b.InFlightOperations()
+	require.Len(t, ops, 2)
+	require.Equal(t, "job-1", ops[0].ID)
+	require.Equal(t, "up", ops[0].Name)
+	require.NotEqual(t, ops[0].ID, ops[1].ID)
+	require.Equal(t, "job-1", ops[1].JobID)
+
+	require.NoError(t, b.CancelOperation(ops[1].ID))
+	require.Error(t, ctx2.Err())
+	require.NoError(t, ctx1.Err())
+}
+
+func TestBasisCancelOperationNotFound(t *testing.T) {
+	b := TestBasis(t)
+
+	_, done := b.operations.track(context.Background(), nil, "status")
+	id := b.InFlightOperations()[0].ID
+	done()
+	require.Empty(t, b.InFlightOperations())
+
+	for _, id := range []string{id, "unknown"} {
+		err := b.CancelOperation(id)
+		var nf *OperationNotFoundError
+		require.True(t, errors.As(err, &nf))
+		require.Equal(t, id, nf.ID)
+	}
+}
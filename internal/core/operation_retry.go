@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/hashicorp/vagrant/internal/config"
+)
+
+// RetryableError is implemented by errors which can flag
+// that the failed operation may be retried
+type RetryableError interface {
+	error
+	Retryable() bool
+}
+
+// Retryable wraps the given error to mark it as retryable
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &retryableError{err: err}
+}
+
+// IsRetryable returns if the error has been marked as retryable
+func IsRetryable(err error) bool {
+	var rErr RetryableError
+	if errors.As(err, &rErr) {
+		return rErr.Retryable()
+	}
+
+	return false
+}
+
+type retryableError struct {
+	err error
+}
+
+// Error implements error
+func (r *retryableError) Error() string {
+	return r.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (r *retryableError) Unwrap() error {
+	return r.err
+}
+
+// Retryable implements RetryableError
+func (r *retryableError) Retryable() bool {
+	return true
+}
+
+// Default limits applied to operation retries
+const (
+	retryDefaultMaxBackoff = time.Minute
+	retryDefaultJitter     = 0.1
+)
+
+// operationRetry is the retry policy applied to operations
+type operationRetry struct {
+	attempts   int           // maximum number of retries
+	backoff    time.Duration // initial delay between retries, doubled after each retry
+	maxBackoff time.Duration // maximum delay between retries
+	jitter     float64       // fraction of the delay randomly added to each delay
+	operation  string        // operation type the policy applies to, empty for all
+}
+
+// RetryOption is used to set options for WithOperationRetry
+type RetryOption func(*operationRetry)
+
+// RetryForOperation applies the retry policy only to operations
+// of the given type
+func RetryForOperation(name string) RetryOption {
+	return func(r *operationRetry) {
+		r.operation = name
+	}
+}
+
+// RetryMaxBackoff sets the maximum delay between retries
+func RetryMaxBackoff(d time.Duration) RetryOption {
+	return func(r *operationRetry) {
+		r.maxBackoff = d
+	}
+}
+
+// RetryJitter sets the fraction of the delay which is randomly
+// added to each delay. A value of zero disables jitter.
+func RetryJitter(fraction float64) RetryOption {
+	return func(r *operationRetry) {
+		r.jitter = fraction
+	}
+}
+
+// Create a new retry policy
+func newOperationRetry(n int, backoff time.Duration, opts ...RetryOption) (*operationRetry, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("operation retry count cannot be negative")
+	}
+
+	r := &operationRetry{
+		attempts:   n,
+		backoff:    backoff,
+		maxBackoff: retryDefaultMaxBackoff,
+		jitter:     retryDefaultJitter,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.jitter < 0 {
+		return nil, fmt.Errorf("operation retry jitter cannot be negative")
+	}
+
+	return r, nil
+}
+
+// Create a retry policy from the configuration
+func retryFromConfig(c *config.Retry) (*operationRetry, error) {
+	opts := []RetryOption{}
+	if c.Operation != "*" {
+		opts = append(opts, RetryForOperation(c.Operation))
+	}
+
+	var err error
+	var backoff, maxBackoff time.Duration
+	if c.Backoff != "" {
+		if backoff, err = time.ParseDuration(c.Backoff); err != nil {
+			return nil, fmt.Errorf("invalid backoff for %s operation retry: %w", c.Operation, err)
+		}
+	}
+	if c.MaxBackoff != "" {
+		if maxBackoff, err = time.ParseDuration(c.MaxBackoff); err != nil {
+			return nil, fmt.Errorf("invalid max backoff for %s operation retry: %w", c.Operation, err)
+		}
+		opts = append(opts, RetryMaxBackoff(maxBackoff))
+	}
+	if c.Jitter != 0 {
+		opts = append(opts, RetryJitter(c.Jitter))
+	}
+
+	return newOperationRetry(c.Attempts, backoff, opts...)
+}
+
+// Name of the operation type used for matching retry policies.
+// Operations can provide a name by implementing a Name method,
+// otherwise the type name is used.
+func operationName(op operation) string {
+	if n, ok := op.(interface{ Name() string }); ok {
+		return n.Name()
+	}
+
+	t := reflect.TypeOf(op)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(strings.ToLower(t.Name()), "operation")
+}
+
+// Compute the delay for the next retry including jitter
+func (r *operationRetry) delay(current time.Duration) time.Duration {
+	if r.maxBackoff > 0 && current > r.maxBackoff {
+		current = r.maxBackoff
+	}
+	if r.jitter > 0 && current > 0 {
+		current += time.Duration(rand.Int63n(int64(float64(current)*r.jitter) + 1))
+	}
+
+	return current
+}
+
+// Run the function, retrying if it fails with a retryable error
+// until the maximum number of retries is reached. A nil policy
+// will only run the function once. Retries are not attempted if
+// the delay would exceed the deadline of the context.
+func (r *operationRetry) do(
+	ctx context.Context, // context for the operation
+	log hclog.Logger, // logger for retry attempts
+	ui terminal.UI, // UI to report retry attempts, may be nil
+	fn func() (interface{}, error), // function to run
+) (result interface{}, err error) {
+	result, err = fn()
+	if r == nil {
+		return
+	}
+
+	backoff := r.backoff
+	for attempt := 1; err != nil && attempt <= r.attempts; attempt++ {
+		if !IsRetryable(err) {
+			return
+		}
+
+		delay := r.delay(backoff)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			log.Warn("operation retry would exceed deadline, not retrying",
+				"attempt", attempt,
+				"delay", delay,
+				"error", err,
+			)
+
+			return
+		}
+
+		log.Warn("operation failed with retryable error, retrying",
+			"attempt", attempt,
+			"max", r.attempts,
+			"delay", delay,
+			"error", err,
+		)
+		if ui != nil {
+			ui.Output("Operation failed with a retryable error, retrying in %s (attempt %d of %d)",
+				delay, attempt, r.attempts, terminal.WithWarningStyle())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		backoff *= 2
+
+		result, err = fn()
+	}
+
+	return
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/hashicorp/vagrant/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationRetry(t *testing.T) {
+	type test struct {
+		policy   *operationRetry
+		err      error
+		expected int
+	}
+
+	tests := []test{
+		{policy: nil, err: Retryable(errors.New("transient")), expected: 1},
+		{policy: &operationRetry{attempts: 2}, err: Retryable(errors.New("transient")), expected: 3},
+		{policy: &operationRetry{attempts: 2}, err: errors.New("fatal"), expected: 1},
+	}
+
+	for _, tc := range tests {
+		calls := 0
+		_, err := tc.policy.do(context.Background(), hclog.NewNullLogger(), nil,
+			func() (interface{}, error) {
+				calls++
+				return nil, tc.err
+			},
+		)
+		require.Error(t, err)
+		require.Equal(t, tc.expected, calls)
+	}
+
+	calls := 0
+	result, err := (&operationRetry{attempts: 3}).do(context.Background(), hclog.NewNullLogger(), nil,
+		func() (interface{}, error) {
+			calls++
+			if calls < 2 {
+				return nil, Retryable(errors.New("transient"))
+			}
+			return "done", nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "done", result)
+	require.Equal(t, 2, calls)
+}
+
+func TestOperationRetryDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	policy, err := newOperationRetry(5, time.Second)
+	require.NoError(t, err)
+	start := time.Now()
+	_, err = policy.do(ctx, hclog.NewNullLogger(), nil,
+		func() (interface{}, error) {
+			calls++
+			return nil, Retryable(errors.New("transient"))
+		},
+	)
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestOperationRetryDelay(t *testing.T) {
+	policy, err := newOperationRetry(1, time.Second,
+		RetryMaxBackoff(2*time.Second),
+		RetryJitter(0.5),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		d := policy.delay(10 * time.Second)
+		require.GreaterOrEqual(t, d, 2*time.Second)
+		require.LessOrEqual(t, d, 3*time.Second)
+	}
+
+	_, err = newOperationRetry(-1, time.Second)
+	require.Error(t, err)
+	_, err = newOperationRetry(1, time.Second, RetryJitter(-1))
+	require.Error(t, err)
+}
+
+func TestOperationRetryReportsUI(t *testing.T) {
+	ui := &testStyleUI{}
+	calls := 0
+	_, err := (&operationRetry{attempts: 2}).do(context.Background(), hclog.NewNullLogger(), ui,
+		func() (interface{}, error) {
+			calls++
+			return nil, Retryable(errors.New("transient"))
+		},
+	)
+	require.Error(t, err)
+	require.Equal(t, 3, calls)
+	require.Equal(t, []string{terminal.WarningStyle, terminal.WarningStyle}, ui.styles)
+}
+
+type testNamedOperation struct {
+	operation
+}
+
+func (testNamedOperation) Name() string { return "up" }
+
+type testRetryOperation struct {
+	operation
+}
+
+func TestBasisRetryPolicy(t *testing.T) {
+	b := TestBasis(t,
+		WithOperationRetry(1, time.Millisecond),
+		WithOperationRetry(4, time.Millisecond, RetryForOperation("up")),
+		WithConfig(&config.Config{
+			Retries: []*config.Retry{
+				{Operation: "up", Attempts: 9},
+				{Operation: "testretry", Attempts: 3, Backoff: "2s"},
+			},
+		}),
+	)
+
+	require.Equal(t, 4, b.retryPolicy(&testNamedOperation{}).attempts)
+	require.Equal(t, 3, b.retryPolicy(&testRetryOperation{}).attempts)
+	require.Equal(t, 2*time.Second, b.retryPolicy(&testRetryOperation{}).backoff)
+	require.Equal(t, 1, b.retryPolicy(nil).attempts)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Pairs of basis options which set the same values and
+// cannot be used together
+var conflictingBasisOptions = [][2]string{
+	{"WithClient", "FromBasis"},
+	{"WithBasisRef", "WithBasisResourceId"},
+	{"WithBasisPath", "WithConfig"},
+	{"WithBasisPath", "WithBasisDataDir"},
+	{"WithMachineReadableUI", "WithUIRenderer"},
+}
+
+// Record that an option was applied to the basis
+func (b *Basis) optionApplied(name string) {
+	if b.appliedOpts == nil {
+		b.appliedOpts = map[string]struct{}{}
+	}
+	b.appliedOpts[name] = struct{}{}
+}
+
+// Check that no conflicting options were applied
+func (b *Basis) checkOptionConflicts() error {
+	var err error
+	for _, pair := range conflictingBasisOptions {
+		_, first := b.appliedOpts[pair[0]]
+		_, second := b.appliedOpts[pair[1]]
+		if first && second {
+			err = multierror.Append(err, fmt.Errorf("%w: %s and %s cannot be used together",
+				ErrConflictingOptions, pair[0], pair[1]))
+		}
+	}
+
+	return err
+}
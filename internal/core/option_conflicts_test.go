@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/config"
+)
+
+func TestBasisOptionConflicts(t *testing.T) {
+	root := t.TempDir()
+	from := TestBasis(t)
+
+	cases := []struct {
+		name    string
+		options []BasisOption
+	}{
+		{
+			"WithClient and FromBasis",
+			[]BasisOption{WithClient(from.client), FromBasis(from)},
+		},
+		{
+			"WithBasisRef and WithBasisResourceId",
+			[]BasisOption{
+				WithClient(from.client),
+				WithBasisRef(&vagrant_plugin_sdk.Ref_Basis{Name: "other"}),
+				WithBasisResourceId(from.basis.ResourceId),
+			},
+		},
+		{
+			"WithBasisPath and WithConfig",
+			[]BasisOption{WithBasisPath(root), WithConfig(&config.Config{})},
+		},
+		{
+			"WithBasisPath and WithBasisDataDir",
+			[]BasisOption{WithBasisPath(root), WithBasisDataDir(from.dir)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewBasis(context.Background(), tc.options...)
+			require.ErrorIs(t, err, ErrConflictingOptions)
+			require.Contains(t, err.Error(), tc.name+" cannot be used together")
+		})
+	}
+}
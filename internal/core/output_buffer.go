@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// Default number of bytes of operation output retained
+const defaultOutputLimit = 64 * 1024
+
+// outputBuffer retains the most recent output written to it up
+// to a fixed size. Older output is dropped once the size is
+// reached.
+type outputBuffer struct {
+	active  int    // number of operations writing output
+	data    []byte // ring storage
+	start   int    // position of the oldest stored byte
+	length  int    // number of stored bytes
+	dropped int64  // number of bytes dropped since reset
+
+	m sync.Mutex
+}
+
+func newOutputBuffer(size int) *outputBuffer {
+	return &outputBuffer{data: make([]byte, size)}
+}
+
+// Write implements io.Writer
+func (o *outputBuffer) Write(p []byte) (int, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	n, size := len(p), len(o.data)
+	if n >= size {
+		o.dropped += int64(o.length + n - size)
+		copy(o.data, p[n-size:])
+		o.start, o.length = 0, size
+
+		return n, nil
+	}
+
+	if over := o.length + n - size; over > 0 {
+		o.start = (o.start + over) % size
+		o.length -= over
+		o.dropped += int64(over)
+	}
+	end := (o.start + o.length) % size
+	c := copy(o.data[end:], p)
+	copy(o.data, p[c:])
+	o.length += n
+
+	return n, nil
+}
+
+// Start capturing the output of an operation. Stored output
+// is removed unless another operation is still running, so
+// the output of a running operation is never lost. The
+// returned function must be called once the operation is
+// complete.
+func (o *outputBuffer) begin() func() {
+	if o == nil {
+		return func() {}
+	}
+
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	if o.active == 0 {
+		o.start, o.length, o.dropped = 0, 0, 0
+	}
+	o.active++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			o.m.Lock()
+			defer o.m.Unlock()
+
+			o.active--
+		})
+	}
+}
+
+// Stored output. If output was dropped the result starts
+// with a marker noting how much was dropped.
+func (o *outputBuffer) String() string {
+	if o == nil {
+		return ""
+	}
+
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	result := make([]byte, 0, o.length)
+	if end := o.start + o.length; end <= len(o.data) {
+		result = append(result, o.data[o.start:end]...)
+	} else {
+		result = append(result, o.data[o.start:]...)
+		result = append(result, o.data[:end-len(o.data)]...)
+	}
+
+	if o.dropped > 0 {
+		return fmt.Sprintf("...output truncated, %d bytes dropped...\n", o.dropped) +
+			string(result)
+	}
+
+	return string(result)
+}
+
+// captureUI wraps a UI and copies output into an output
+// buffer while continuing to send it to the wrapped UI
+type captureUI struct {
+	terminal.UI
+
+	buf *outputBuffer
+}
+
+// Wrap the UI so output is captured in the buffer. If the UI
+// is already capturing output, the capture is replaced.
+func newCaptureUI(ui terminal.UI, buf *outputBuffer) terminal.UI {
+	if c, ok := ui.(*captureUI); ok {
+		ui = c.UI
+	}
+
+	return &captureUI{UI: ui, buf: buf}
+}
+
+func (u *captureUI) unwrapUI() terminal.UI {
+	return u.UI
+}
+
+// ReportError outputs the error details if the wrapped UI
+// supports structured errors
+func (u *captureUI) ReportError(d *ErrorDetails) {
+	if r, ok := u.UI.(errorReporter); ok {
+		r.ReportError(d)
+	}
+}
+
+// Output implements terminal.UI
+func (u *captureUI) Output(msg string, raw ...interface{}) {
+	line, _, _, _, _ := terminal.Interpret(msg, raw...)
+	io.WriteString(u.buf, line+"\n")
+
+	u.UI.Output(msg, raw...)
+}
+
+// OutputWriters implements terminal.UI
+func (u *captureUI) OutputWriters() (stdout, stderr io.Writer, err error) {
+	if stdout, stderr, err = u.UI.OutputWriters(); err != nil {
+		return
+	}
+
+	return io.MultiWriter(stdout, u.buf), io.MultiWriter(stderr, u.buf), nil
+}
+
+// StepGroup implements terminal.UI
+func (u *captureUI) StepGroup() terminal.StepGroup {
+	return &captureStepGroup{StepGroup: u.UI.StepGroup(), buf: u.buf}
+}
+
+type captureStepGroup struct {
+	terminal.StepGroup
+
+	buf *outputBuffer
+}
+
+// Add implements terminal.StepGroup
+func (g *captureStepGroup) Add(msg string, args ...interface{}) terminal.Step {
+	return &captureStep{Step: g.StepGroup.Add(msg, args...), buf: g.buf}
+}
+
+type captureStep struct {
+	terminal.Step
+
+	buf *outputBuffer
+}
+
+// TermOutput implements terminal.Step
+func (s *captureStep) TermOutput() io.Writer {
+	return io.MultiWriter(s.Step.TermOutput(), s.buf)
+}
+
+// LastOperationOutput returns the output of the most recently
+// started command. Only the most recent output, up to the limit
+// set with WithOperationOutputLimit, is retained. If output was
+// dropped the result starts with a truncation marker.
+//
+// The output is captured from the basis UI, which is shared by
+// all commands run by the basis. When commands run concurrently,
+// such as on multiple targets, the output is retained from when
+// the first of them started and contains the interleaved output
+// of all of them.
+func (b *Basis) LastOperationOutput() string {
+	return b.output.String()
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputBuffer(t *testing.T) {
+	o := newOutputBuffer(10)
+	fmt.Fprint(o, "abcdef")
+	require.Equal(t, "abcdef", o.String())
+
+	// Wrapping drops the oldest output
+	fmt.Fprint(o, "ghijkl")
+	require.Equal(t, "...output truncated, 2 bytes dropped...\ncdefghijkl", o.String())
+
+	// Writes larger than the buffer keep only the end
+	fmt.Fprint(o, strings.Repeat("x", 5)+"0123456789")
+	require.Equal(t, "...output truncated, 17 bytes dropped...\n0123456789", o.String())
+
+	end := o.begin()
+	require.Equal(t, "", o.String())
+	fmt.Fprint(o, "mn")
+	require.Equal(t, "mn", o.String())
+
+	// Output is kept while another operation is running
+	o.begin()()
+	fmt.Fprint(o, "op")
+	require.Equal(t, "mnop", o.String())
+
+	end()
+	defer o.begin()()
+	require.Equal(t, "", o.String())
+}
+
+func TestBasisLastOperationOutput(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithOperationOutputLimit(0))
+	require.Error(t, err)
+
+	rec := &testRecordUI{}
+	b := TestBasis(t, WithUI(rec), WithOperationOutputLimit(16))
+
+	ui, err := b.UI()
+	require.NoError(t, err)
+	ui.Output("first line")
+	ui.Output("second %s", "line")
+
+	// Output is still sent to the wrapped UI
+	require.Equal(t, []string{"first line", "second line"}, rec.lines)
+	require.Equal(t, "...output truncated, 7 bytes dropped...\nine\nsecond line\n",
+		b.LastOperationOutput())
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// PluginCapMode controls the behavior when the maximum
+// number of plugins has been reached
+type PluginCapMode uint
+
+const (
+	PluginCapBlock PluginCapMode = iota // wait for a plugin process to be stopped
+	PluginCapError                      // return ErrPluginCapReached
+)
+
+func (m PluginCapMode) String() string {
+	switch m {
+	case PluginCapError:
+		return "error"
+	default:
+		return "block"
+	}
+}
+
+// PluginCapStats provides information about plugin processes
+// started, reaped, and restricted by the plugin cap
+type PluginCapStats struct {
+	Spawned uint64 // number of plugin processes started
+	Reaped  uint64 // number of idle plugin processes stopped
+	CapHits uint64 // number of startups which reached the cap
+}
+
+// pluginCap holds the settings which restrict the number of
+// running plugin processes and reap idle processes
+type pluginCap struct {
+	max  int           // maximum running processes (zero is unlimited)
+	mode PluginCapMode // behavior when the maximum is reached
+	ttl  time.Duration // idle time before a process is stopped
+}
+
+// Create a new cap with the same settings
+func (c *pluginCap) copy() *pluginCap {
+	return &pluginCap{
+		max:  c.max,
+		mode: c.mode,
+		ttl:  c.ttl,
+	}
+}
+
+// PluginCapStats returns statistics about the plugin processes
+// started and reaped by the plugin manager of the basis
+func (b *Basis) PluginCapStats() PluginCapStats {
+	if b.plugins == nil {
+		return PluginCapStats{}
+	}
+
+	s := b.plugins.ProcessStats()
+	return PluginCapStats{
+		Spawned: s.Started,
+		Reaped:  s.Stopped,
+		CapHits: s.LimitHits,
+	}
+}
+
+// Apply the cap to the plugin processes of the plugin manager
+func (b *Basis) applyPluginCap() {
+	max, wait := b.pluginProcessLimit()
+	if max < 1 {
+		return
+	}
+
+	b.plugins.LimitProcesses(max, wait)
+}
+
+// Launch the process of the plugin if it was stopped, waiting
+// for the plugin cap. EventPluginStarted is only emitted when
+// a new process was launched.
+func (b *Basis) startPlugin(
+	ctx context.Context,
+	typ component.Type,
+	name string,
+) error {
+	started, err := b.plugins.Start(ctx, name, typ)
+	if err != nil {
+		max, wait := b.pluginProcessLimit()
+		b.logger.Warn("failed to start plugin process",
+			"type", typ.String(),
+			"name", name,
+			"max", max,
+			"wait", wait,
+			"error", err,
+		)
+
+		return fmt.Errorf("cannot start plugin %s: %w", name, err)
+	}
+
+	if started {
+		b.events.emit(EventPluginStarted, name, typ.String(), nil)
+		b.logger.Debug("plugin process started",
+			"type", typ.String(),
+			"name", name,
+			"running", b.plugins.ProcessStats().Running,
+		)
+	}
+
+	return nil
+}
+
+// Stop plugin processes which have been idle longer than the
+// TTL. Reaped plugins are started again when next requested.
+func (b *Basis) reapPlugins(ttl time.Duration) {
+	for _, p := range b.plugins.ReapIdle(ttl) {
+		types := make([]string, 0, len(p.Types))
+		for _, t := range p.Types {
+			types = append(types, t.String())
+		}
+
+		b.logger.Debug("reaped idle plugin process",
+			"name", p.Name,
+			"types", types,
+		)
+		b.events.emit(EventPluginReaped, p.Name, strings.Join(types, ","), nil)
+	}
+}
+
+// Start reaping idle plugin processes if a TTL has been set.
+// Reaping stops when the basis is closed.
+func (b *Basis) startPluginReaper() {
+	ttl := b.pluginCap.ttl
+	if ttl <= 0 {
+		return
+	}
+
+	done := make(chan struct{})
+	b.Closer(func() error {
+		close(done)
+		return nil
+	})
+
+	go func() {
+		ticker := time.NewTicker(ttl / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-b.ctx.Done():
+				return
+			case <-ticker.C:
+				b.reapPlugins(ttl)
+			}
+		}
+	}()
+}
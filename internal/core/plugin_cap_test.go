@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+func testCapHostPlugin(t *testing.T, name string) *plugin.Plugin {
+	h := BuildTestHostPlugin(name, "")
+	h.On("Close").Return(nil)
+	return plugin.TestPlugin(t,
+		h,
+		plugin.WithPluginName(name),
+		plugin.WithPluginTypes(component.HostType),
+	)
+}
+
+func TestBasisPluginCapOptions(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithMaxPlugins(0))
+	require.Error(t, err)
+	_, err = NewBasis(context.Background(), WithPluginIdleTTL(0))
+	require.Error(t, err)
+	_, err = NewBasis(context.Background(), WithPluginCapMode(PluginCapMode(9)))
+	require.Error(t, err)
+}
+
+// Create a plugin manager with plugins which are handled like
+// plugin processes. The processes are stopped so they are started
+// by the basis when requested.
+func testProcessManager(t *testing.T, plugins ...func() *plugin.Plugin) *plugin.Manager {
+	m := plugin.TestManager(t)
+	for _, fn := range plugins {
+		require.NoError(t, m.Register(plugin.TestProcessPlugin(m, fn)))
+	}
+	require.Len(t, m.ReapIdle(0), len(plugins))
+
+	return m
+}
+
+// Create a plugin manager with host plugin processes
+func testCapManager(t *testing.T, names ...string) *plugin.Manager {
+	plugins := []func() *plugin.Plugin{}
+	for _, name := range names {
+		name := name
+		plugins = append(plugins, func() *plugin.Plugin {
+			return testCapHostPlugin(t, name)
+		})
+	}
+
+	return testProcessManager(t, plugins...)
+}
+
+func TestBasisPluginCapError(t *testing.T) {
+	b := TestBasis(t,
+		WithPluginManager(testCapManager(t, "hosta", "hostb")),
+		WithMaxPlugins(1),
+		WithPluginCapMode(PluginCapError),
+	)
+
+	a, err := b.component(b.ctx, component.HostType, "hosta")
+	require.NoError(t, err)
+
+	// Running plugins do not count against the cap
+	c, err := b.component(b.ctx, component.HostType, "hosta")
+	require.NoError(t, err)
+	c.Release()
+
+	// The running plugin is in use so it cannot be stopped
+	_, err = b.component(b.ctx, component.HostType, "hostb")
+	require.ErrorIs(t, err, ErrPluginCapReached)
+
+	// Once released, the idle plugin is stopped to start another
+	a.Release()
+	_, err = b.component(b.ctx, component.HostType, "hostb")
+	require.NoError(t, err)
+	require.False(t, b.plugins.Cached("hosta", component.HostType))
+
+	stats := b.PluginCapStats()
+	require.Equal(t, uint64(4), stats.Spawned)
+	require.Equal(t, uint64(3), stats.Reaped)
+	require.Equal(t, uint64(2), stats.CapHits)
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+}
+
+func TestBasisPluginCapBlock(t *testing.T) {
+	b := TestBasis(t,
+		WithPluginManager(testCapManager(t, "hosta", "hostb")),
+		WithMaxPlugins(1),
+	)
+
+	a, err := b.component(b.ctx, component.HostType, "hosta")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(b.ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = b.component(ctx, component.HostType, "hostb")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Once the plugin in use is released the blocked startup
+	// continues
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		a.Release()
+	}()
+	_, err = b.component(b.ctx, component.HostType, "hostb")
+	require.NoError(t, err)
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+}
+
+func TestBasisPluginReap(t *testing.T) {
+	b := TestBasis(t,
+		WithPluginManager(testCapManager(t, "hosta")),
+		WithPluginIdleTTL(time.Hour),
+	)
+	events, cancel := b.SubscribeEvents(0)
+	defer cancel()
+
+	c, err := b.component(b.ctx, component.HostType, "hosta")
+	require.NoError(t, err)
+	require.True(t, b.plugins.Cached("hosta", component.HostType))
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+
+	// Nothing is idle long enough to be reaped
+	b.reapPlugins(time.Hour)
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+
+	// Plugins in use are not reaped
+	time.Sleep(time.Millisecond)
+	b.reapPlugins(time.Nanosecond)
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+
+	// Reaping stops the process and closes its instances
+	c.Release()
+	time.Sleep(time.Millisecond)
+	b.reapPlugins(time.Nanosecond)
+	require.Zero(t, b.plugins.ProcessStats().Running)
+	require.False(t, b.plugins.Cached("hosta", component.HostType))
+	require.Zero(t, b.plugins.LiveInstances())
+
+	// A reaped plugin is started again when requested
+	_, err = b.component(b.ctx, component.HostType, "hosta")
+	require.NoError(t, err)
+	require.True(t, b.plugins.Cached("hosta", component.HostType))
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+
+	stats := b.PluginCapStats()
+	require.Equal(t, uint64(3), stats.Spawned)
+	require.Equal(t, uint64(2), stats.Reaped)
+
+	started, reaped := 0, 0
+	for len(events) > 0 {
+		switch e := <-events; e.Type {
+		case EventPluginStarted:
+			started++
+		case EventPluginReaped:
+			reaped++
+		}
+	}
+	require.Equal(t, 2, started)
+	require.Equal(t, 1, reaped)
+}
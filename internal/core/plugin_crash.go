@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/version"
+)
+
+// PluginCrashError is returned when a plugin process exits while
+// it is in use. Diagnostics for the crash are written to the
+// bundle file.
+type PluginCrashError struct {
+	Plugin   string         // name of the plugin
+	Version  string         // version of the plugin, empty if unknown
+	Type     component.Type // type of component in use
+	ExitCode int            // exit code of the plugin process
+	Bundle   string         // path of the diagnostics bundle
+	Err      error          // error returned by the plugin call
+}
+
+// Error implements error
+func (e *PluginCrashError) Error() string {
+	name := e.Plugin
+	if e.Version != "" {
+		name += " " + e.Version
+	}
+	msg := fmt.Sprintf("plugin %s exited unexpectedly (exit code %d)", name, e.ExitCode)
+	if e.Bundle != "" {
+		msg += fmt.Sprintf(", diagnostics written to %s", e.Bundle)
+	}
+
+	return fmt.Sprintf("%s: %s", msg, e.Err)
+}
+
+// Unwrap returns the error returned by the plugin call
+func (e *PluginCrashError) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostics written when a plugin process crashes
+type pluginCrashBundle struct {
+	Time          time.Time `json:"time"`
+	Vagrant       string    `json:"vagrant_version"`
+	Plugin        string    `json:"plugin"`
+	PluginVersion string    `json:"plugin_version,omitempty"`
+	Type          string    `json:"type"`
+	Location      string    `json:"location"`
+	ExitCode      int       `json:"exit_code"`
+	Error         string    `json:"error"`
+	Stderr        string    `json:"stderr"`
+}
+
+// Check if the error from a call to the plugin instance was caused
+// by the plugin process exiting. If it was, a diagnostics bundle is
+// written to the basis temporary directory and the returned error
+// includes the bundle path. Otherwise the original error is returned.
+func (b *Basis) pluginCrash(
+	err error, // error from the plugin call
+	inst *plugin.Instance, // instance which was called
+) error {
+	if err == nil || b.plugins == nil {
+		return err
+	}
+
+	info := b.plugins.Crashed(inst.Name, inst.Type)
+	if info == nil {
+		return err
+	}
+
+	crashErr := &PluginCrashError{
+		Plugin:   inst.Name,
+		Version:  inst.Version,
+		Type:     inst.Type,
+		ExitCode: info.ExitCode,
+		Err:      err,
+	}
+
+	bundle, berr := b.writeCrashBundle(inst, info, err)
+	if berr != nil {
+		b.logger.Warn("failed to write plugin crash diagnostics",
+			"plugin", inst.Name,
+			"error", berr,
+		)
+	}
+	crashErr.Bundle = bundle
+
+	b.logger.Error("plugin process exited unexpectedly",
+		"type", inst.Type.String(),
+		"name", inst.Name,
+		"version", inst.Version,
+		"exit-code", info.ExitCode,
+		"bundle", bundle,
+	)
+
+	return crashErr
+}
+
+// Check if the error from loading a component was caused by
+// the plugin process exiting. No instance of the component
+// exists, so the bundle only identifies the plugin by name.
+func (b *Basis) pluginLoadCrash(
+	err error, // error from loading the component
+	typ component.Type, // type of component loaded
+	name string, // name of the plugin
+) error {
+	return b.pluginCrash(err, &plugin.Instance{Name: name, Type: typ})
+}
+
+// Write the diagnostics bundle for a crashed plugin and
+// return the path of the bundle
+func (b *Basis) writeCrashBundle(
+	inst *plugin.Instance, // instance which was called
+	info *plugin.CrashInfo, // information about the crash
+	callErr error, // error from the plugin call
+) (string, error) {
+	dir := os.TempDir()
+	if b.dir != nil {
+		dir = b.dir.TempDir().String()
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	content, err := json.MarshalIndent(&pluginCrashBundle{
+		Time:          time.Now(),
+		Vagrant:       version.GetVersion().FullVersionNumber(false),
+		Plugin:        inst.Name,
+		PluginVersion: inst.Version,
+		Type:          inst.Type.String(),
+		Location:      info.Location,
+		ExitCode:      info.ExitCode,
+		Error:         callErr.Error(),
+		Stderr:        info.Stderr,
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp(dir, fmt.Sprintf("plugin-crash-%s-*.json", inst.Name))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err = f.Write(content); err != nil {
+		return "", err
+	}
+
+	return f.Name(), nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+func TestBasisPluginCrash(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugin process requires a POSIX shell")
+	}
+
+	crashy := plugin.TestPlugin(t, nil,
+		plugin.WithPluginName("crashy"),
+		plugin.WithPluginTypes(component.CommandType),
+		plugin.WithPluginProcess(exec.Command("/bin/sh", "-c", "echo something broke >&2; exit 3")),
+	)
+	defer crashy.Close()
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, crashy)))
+	require.Eventually(t, func() bool {
+		return b.plugins.Crashed("crashy", component.CommandType) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	callErr := errors.New("transport is closing")
+	inst := &plugin.Instance{Name: "crashy", Type: component.CommandType, Version: "1.2.3"}
+	err := b.pluginCrash(callErr, inst)
+	require.ErrorIs(t, err, callErr)
+
+	var crashErr *PluginCrashError
+	require.ErrorAs(t, err, &crashErr)
+	require.Equal(t, 3, crashErr.ExitCode)
+	require.Equal(t, "1.2.3", crashErr.Version)
+	require.Contains(t, err.Error(), "plugin crashy 1.2.3 exited")
+	require.Contains(t, err.Error(), crashErr.Bundle)
+	require.Equal(t, "plugin_crash", NewErrorDetails(err).Code)
+
+	content, err := os.ReadFile(crashErr.Bundle)
+	require.NoError(t, err)
+	require.Contains(t, string(content), `"plugin": "crashy"`)
+	require.Contains(t, string(content), `"plugin_version": "1.2.3"`)
+	require.Contains(t, string(content), `"exit_code": 3`)
+	require.Contains(t, string(content), "something broke")
+	require.Contains(t, string(content), "transport is closing")
+
+	// Errors from running plugins are returned unchanged
+	require.Equal(t, callErr, b.pluginLoadCrash(callErr, component.CommandType, "unknown"))
+	require.NoError(t, b.pluginCrash(nil, inst))
+
+	// Crashes while loading a component are identified by name
+	err = b.pluginLoadCrash(callErr, component.CommandType, "crashy")
+	require.ErrorAs(t, err, &crashErr)
+	require.Equal(t, "crashy", crashErr.Plugin)
+	require.Empty(t, crashErr.Version)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"time"
+)
+
+// PluginQueueStats provides information about plugin startups
+// which were queued due to the concurrency limit
+type PluginQueueStats struct {
+	Started   uint64        // number of plugin processes started
+	Queued    uint64        // number of startups which had to wait
+	TotalWait time.Duration // total time spent waiting
+	MaxWait   time.Duration // longest time spent waiting
+}
+
+// PluginQueueStats returns statistics about plugin startups
+// queued by the limit set with WithMaxConcurrentPlugins
+func (b *Basis) PluginQueueStats() PluginQueueStats {
+	if b.plugins == nil {
+		return PluginQueueStats{}
+	}
+
+	s := b.plugins.ProcessStats()
+	return PluginQueueStats{
+		Started:   s.Started,
+		Queued:    s.Queued,
+		TotalWait: s.TotalWait,
+		MaxWait:   s.MaxWait,
+	}
+}
+
+// Limit on running plugin processes and if startups wait for
+// a process to stop when the limit is reached. The lower of
+// the concurrency limit and the plugin cap is used.
+func (b *Basis) pluginProcessLimit() (max int, wait bool) {
+	max, wait = b.pluginCap.max, b.pluginCap.mode == PluginCapBlock
+	if b.pluginLimit > 0 && (max < 1 || b.pluginLimit < max) {
+		max, wait = b.pluginLimit, true
+	}
+
+	return
+}
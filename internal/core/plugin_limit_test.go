@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisMaxConcurrentPlugins(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithMaxConcurrentPlugins(0))
+	require.Error(t, err)
+
+	b := TestBasis(t,
+		WithPluginManager(testCapManager(t, "hosta", "hostb")),
+		WithMaxConcurrentPlugins(1),
+	)
+
+	a, err := b.component(b.ctx, component.HostType, "hosta")
+	require.NoError(t, err)
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+
+	// The second plugin is queued until the first is released
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		a.Release()
+	}()
+	_, err = b.component(b.ctx, component.HostType, "hostb")
+	require.NoError(t, err)
+	require.Equal(t, 1, b.plugins.ProcessStats().Running)
+
+	stats := b.PluginQueueStats()
+	require.Equal(t, uint64(4), stats.Started)
+	require.Equal(t, uint64(1), stats.Queued)
+	require.GreaterOrEqual(t, stats.TotalWait, 10*time.Millisecond)
+	require.Equal(t, stats.TotalWait, stats.MaxWait)
+}
+
+func TestBasisPluginProcessLimit(t *testing.T) {
+	b := &Basis{pluginCap: &pluginCap{}}
+	max, _ := b.pluginProcessLimit()
+	require.Zero(t, max)
+
+	// The lower limit applies
+	b.pluginCap = &pluginCap{max: 2, mode: PluginCapError}
+	b.pluginLimit = 3
+	max, wait := b.pluginProcessLimit()
+	require.Equal(t, 2, max)
+	require.False(t, wait)
+
+	b.pluginLimit = 1
+	max, wait = b.pluginProcessLimit()
+	require.Equal(t, 1, max)
+	require.True(t, wait)
+}
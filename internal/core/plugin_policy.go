@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// PluginPolicy restricts which plugins can be used by the basis.
+// Entries are glob patterns in the format `type:name`, for example
+// `provider:virtualbox` or `*:docker`. An entry without a type
+// matches the name for any type. Deny entries take precedence. When
+// the allow list is not empty, any plugin not matching an allow
+// entry is denied.
+type PluginPolicy struct {
+	Allow []string
+	Deny  []string
+}
+
+// Validate checks that all entries in the policy are valid patterns
+func (p *PluginPolicy) Validate() error {
+	for _, entry := range append(append([]string{}, p.Allow...), p.Deny...) {
+		typ, name := splitPolicyEntry(entry)
+		if _, err := path.Match(typ, ""); err != nil {
+			return fmt.Errorf("invalid plugin policy entry %q: %w", entry, err)
+		}
+		if _, err := path.Match(name, ""); err != nil {
+			return fmt.Errorf("invalid plugin policy entry %q: %w", entry, err)
+		}
+	}
+
+	return nil
+}
+
+// Allowed checks if the plugin is allowed by the policy. If the plugin
+// is denied, ErrPluginDenied is returned.
+func (p *PluginPolicy) Allowed(typ component.Type, name string) error {
+	if p == nil {
+		return nil
+	}
+
+	if entry, ok := p.match(p.Deny, typ, name); ok {
+		return fmt.Errorf("%w: %s plugin %q matches deny entry %q",
+			ErrPluginDenied, strings.ToLower(typ.String()), name, entry)
+	}
+
+	if len(p.Allow) == 0 {
+		return nil
+	}
+
+	if _, ok := p.match(p.Allow, typ, name); ok {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s plugin %q is not in the allow list",
+		ErrPluginDenied, strings.ToLower(typ.String()), name)
+}
+
+// Find the first entry matching the plugin type and name
+func (p *PluginPolicy) match(
+	entries []string, // policy entries
+	typ component.Type, // type of the plugin
+	name string, // name of the plugin
+) (string, bool) {
+	typName := strings.ToLower(typ.String())
+	for _, entry := range entries {
+		etyp, ename := splitPolicyEntry(entry)
+		etyp = strings.ReplaceAll(strings.ToLower(etyp), "_", "")
+
+		if ok, _ := path.Match(etyp, typName); !ok {
+			continue
+		}
+		if ok, _ := path.Match(ename, name); ok {
+			return entry, true
+		}
+	}
+
+	return "", false
+}
+
+// Split a policy entry into type and name patterns
+func splitPolicyEntry(entry string) (typ, name string) {
+	parts := strings.SplitN(entry, ":", 2)
+	if len(parts) == 1 {
+		return "*", parts[0]
+	}
+
+	return parts[0], parts[1]
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant/internal/config"
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginPolicyAllowed(t *testing.T) {
+	tests := []struct {
+		policy  *PluginPolicy
+		typ     component.Type
+		name    string
+		allowed bool
+	}{
+		{policy: nil, typ: component.ProviderType, name: "virtualbox", allowed: true},
+		{policy: &PluginPolicy{}, typ: component.ProviderType, name: "virtualbox", allowed: true},
+		{policy: &PluginPolicy{Deny: []string{"provider:docker"}}, typ: component.ProviderType, name: "docker", allowed: false},
+		{policy: &PluginPolicy{Deny: []string{"provider:docker"}}, typ: component.GuestType, name: "docker", allowed: true},
+		{policy: &PluginPolicy{Deny: []string{"docker"}}, typ: component.GuestType, name: "docker", allowed: false},
+		{policy: &PluginPolicy{Deny: []string{"*:vmware_*"}}, typ: component.ProviderType, name: "vmware_desktop", allowed: false},
+		{policy: &PluginPolicy{Deny: []string{"synced_folder:*"}}, typ: component.SyncedFolderType, name: "nfs", allowed: false},
+		{policy: &PluginPolicy{Allow: []string{"provider:virtualbox"}}, typ: component.ProviderType, name: "virtualbox", allowed: true},
+		{policy: &PluginPolicy{Allow: []string{"provider:virtualbox"}}, typ: component.ProviderType, name: "docker", allowed: false},
+		{policy: &PluginPolicy{Allow: []string{"*"}, Deny: []string{"docker"}}, typ: component.ProviderType, name: "docker", allowed: false},
+	}
+
+	for _, tc := range tests {
+		err := tc.policy.Allowed(tc.typ, tc.name)
+		if tc.allowed {
+			require.NoError(t, err, "%s %s", tc.typ, tc.name)
+		} else {
+			require.True(t, errors.Is(err, ErrPluginDenied), "%s %s", tc.typ, tc.name)
+		}
+	}
+}
+
+func TestPluginPolicyValidate(t *testing.T) {
+	require.NoError(t, (&PluginPolicy{Allow: []string{"provider:*"}}).Validate())
+	require.Error(t, (&PluginPolicy{Deny: []string{"provider:[bad"}}).Validate())
+}
+
+func TestBasisPluginPolicy(t *testing.T) {
+	myguest := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("myguest", ""),
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	myguesttwo := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("myguesttwo", ""),
+		plugin.WithPluginName("myguesttwo"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	pluginManager := plugin.TestManager(t, myguest, myguesttwo)
+
+	b := TestBasis(t,
+		WithPluginManager(pluginManager),
+		WithPluginPolicy(&PluginPolicy{Deny: []string{"guest:myguesttwo"}}),
+	)
+
+	_, err := b.component(context.Background(), component.GuestType, "myguesttwo")
+	require.True(t, errors.Is(err, ErrPluginDenied))
+
+	c, err := b.component(context.Background(), component.GuestType, "myguest")
+	require.NoError(t, err)
+	require.NotNil(t, c)
+
+	comps, err := b.typeComponents(context.Background(), component.GuestType)
+	require.NoError(t, err)
+	require.Len(t, comps, 1)
+	require.Contains(t, comps, "myguest")
+}
+
+func TestBasisPluginPolicyConfig(t *testing.T) {
+	b := TestBasis(t,
+		WithConfig(&config.Config{
+			Plugins: &config.Plugins{Allow: []string{"host:*"}},
+		}),
+	)
+
+	err := b.pluginPolicy.Allowed(component.GuestType, "myguest")
+	require.True(t, errors.Is(err, ErrPluginDenied))
+	require.NoError(t, b.pluginPolicy.Allowed(component.HostType, "myhost"))
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/vagrant-plugin-sdk/helper/path"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+// AddPlugins registers additional plugins with the basis after it
+// has been created. The plugins are added alongside the plugins
+// which are already known, including builtin plugins, instead of
+// replacing them. Plugins added to the basis take precedence over
+// plugins of the same name and type provided by parent managers.
+func (b *Basis) AddPlugins(regs ...plugin.PluginRegistration) error {
+	var err error
+	for _, reg := range regs {
+		if rerr := b.plugins.Register(reg); rerr != nil {
+			err = multierror.Append(err, rerr)
+		}
+	}
+
+	return err
+}
+
+// DiscoverPlugins registers any plugin executables found within
+// the given directories. Like AddPlugins, discovered plugins are
+// added to the plugins already known by the basis.
+func (b *Basis) DiscoverPlugins(dirs ...string) error {
+	paths := make([]path.Path, len(dirs))
+	for i, dir := range dirs {
+		paths[i] = path.NewPath(dir)
+	}
+
+	return b.plugins.Discover(paths...)
+}
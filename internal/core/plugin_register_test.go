@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+func TestBasisAddPlugins(t *testing.T) {
+	myguest := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("myguest", ""),
+		plugin.WithPluginName("myguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	added := plugin.TestPlugin(t,
+		BuildTestGuestPlugin("addedguest", ""),
+		plugin.WithPluginName("addedguest"),
+		plugin.WithPluginTypes(component.GuestType),
+	)
+	b := TestBasis(t, WithPluginManager(plugin.TestManager(t, myguest)))
+
+	require.NoError(t, b.AddPlugins(func(hclog.Logger) (*plugin.Plugin, error) {
+		return added, nil
+	}))
+
+	// Existing plugins are still available along with the new plugin
+	plugins, err := b.plugins.Typed(component.GuestType)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"myguest", "addedguest"}, plugins)
+
+	err = b.AddPlugins(func(hclog.Logger) (*plugin.Plugin, error) {
+		return nil, errors.New("invalid plugin")
+	})
+	require.Error(t, err)
+}
+
+func TestBasisDiscoverPlugins(t *testing.T) {
+	b := TestBasis(t)
+	before := len(b.plugins.Plugins)
+
+	// Directories without plugins register nothing
+	require.NoError(t, b.DiscoverPlugins(t.TempDir()))
+	require.Len(t, b.plugins.Plugins, before)
+}
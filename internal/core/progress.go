@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+
+	"github.com/hashicorp/vagrant/internal/server"
+)
+
+// Progress phases reported by the basis
+const (
+	ProgressPhaseStarted      = "started"
+	ProgressPhaseDownloading  = "downloading"
+	ProgressPhaseExtracting   = "extracting"
+	ProgressPhaseInstalling   = "installing"
+	ProgressPhaseProvisioning = "provisioning"
+	ProgressPhaseCompleted    = "completed"
+	ProgressPhaseFailed       = "failed"
+)
+
+// ProgressEvent describes the progress of a long running
+// operation like a box download or a provision.
+type ProgressEvent struct {
+	OperationID string  // stable identifier of the operation
+	Operation   string  // description of the operation
+	Phase       string  // current phase of the operation
+	Percent     float64 // percent complete, negative if unknown
+	Bytes       int64   // bytes transferred
+	TotalBytes  int64   // total bytes to transfer, zero if unknown
+}
+
+// ProgressReporter receives structured progress events. Events
+// from concurrent operations may be reported concurrently so
+// implementations must be safe for concurrent use.
+type ProgressReporter interface {
+	Report(event *ProgressEvent)
+}
+
+// ProgressReporterFunc allows a function to be used as a ProgressReporter
+type ProgressReporterFunc func(event *ProgressEvent)
+
+// Report implements ProgressReporter
+func (f ProgressReporterFunc) Report(event *ProgressEvent) {
+	f(event)
+}
+
+type noopProgressReporter struct{}
+
+// Report implements ProgressReporter
+func (noopProgressReporter) Report(*ProgressEvent) {}
+
+// progressTracker reports events for a single operation
+type progressTracker struct {
+	id        string
+	operation string
+	reporter  ProgressReporter
+}
+
+// Create a new tracker for an operation with a unique ID
+func newProgressTracker(r ProgressReporter, operation string) (*progressTracker, error) {
+	id, err := server.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	return &progressTracker{
+		id:        id,
+		operation: operation,
+		reporter:  r,
+	}, nil
+}
+
+// Report a phase change with the given percent complete
+func (p *progressTracker) phase(phase string, percent float64) {
+	p.bytes(phase, percent, 0, 0)
+}
+
+// Report a phase change including transfer information
+func (p *progressTracker) bytes(phase string, percent float64, n, total int64) {
+	p.reporter.Report(&ProgressEvent{
+		OperationID: p.id,
+		Operation:   p.operation,
+		Phase:       phase,
+		Percent:     percent,
+		Bytes:       n,
+		TotalBytes:  total,
+	})
+}
+
+// Call a plugin function for an operation while reporting its
+// progress. The function may request Progress to report its own
+// progress using the operation ID. The completed event is left
+// to the caller so it can include transfer information.
+func (b *Basis) callWithProgress(
+	ctx context.Context, // context for the call
+	call dynamicCaller, // calls the function within the scope
+	ui terminal.UI, // UI to render plugin progress
+	operation string, // description of the operation
+	phase string, // phase reported while the function runs
+	f interface{}, // function to call
+	args ...argmapper.Arg, // arguments for the function
+) (*progressTracker, error) {
+	tracker, err := newProgressTracker(b.progress, operation)
+	if err != nil {
+		return nil, err
+	}
+	tracker.phase(ProgressPhaseStarted, 0)
+
+	progress := newProgress(ui, tracker)
+	defer progress.close()
+
+	tracker.phase(phase, -1)
+	args = append(args, argmapper.Typed(progress))
+	if _, err = call(ctx, b.logger, f, false, args...); err != nil {
+		tracker.phase(ProgressPhaseFailed, -1)
+		return nil, err
+	}
+
+	return tracker, nil
+}
+
+var _ ProgressReporter = (*noopProgressReporter)(nil)
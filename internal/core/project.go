@@ -45,6 +45,7 @@ type Project struct {
 	cache       cacher.Cache                // local project cache
 	cleanup     cleanup.Cleanup             // cleanup tasks to be run on close
 	client      *serverclient.VagrantClient // client to vagrant server
+	closed      bool                        // flag that project has been closed
 	ctx         context.Context             // local context
 	dir         *datadir.Project            // data directory for project
 	factory     *Factory                    // scope factory
@@ -61,23 +62,112 @@ type Project struct {
 	m sync.Mutex
 }
 
-// Create a new blank project instance
+// Create a new blank project instance. If the basis provided
+// via options has a project constructor, the constructed project
+// provides any values which were not set by the options.
 func NewProject(opts ...ProjectOption) (*Project, error) {
-	var p *Project
-	var err error
-	p = &Project{
-		cache:   cacher.New(),
-		cleanup: cleanup.New(),
-		ctx:     context.Background(),
-		logger:  hclog.L(),
-		project: &vagrant_server.Project{
-			Configuration: &vagrant_server.Vagrantfile{
-				Unfinalized: &vagrant_plugin_sdk.Args_Hash{},
-				Format:      vagrant_server.Vagrantfile_RUBY,
-			},
+	p, err := applyProjectOptions(&Project{project: newProjectProto()}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.basis != nil && p.basis.projectCtor != nil {
+		c := p.basis.projectCtor(p.basis)
+		if c == nil {
+			return nil, fmt.Errorf("project constructor did not return a project")
+		}
+		p.inherit(c)
+	}
+
+	return projectDefaults(p), nil
+}
+
+// Initial stored project data
+func newProjectProto() *vagrant_server.Project {
+	return &vagrant_server.Project{
+		Configuration: &vagrant_server.Vagrantfile{
+			Unfinalized: &vagrant_plugin_sdk.Args_Hash{},
+			Format:      vagrant_server.Vagrantfile_RUBY,
 		},
 	}
+}
+
+// Use the values of the constructed project for any values
+// which were not set on the project
+func (p *Project) inherit(c *Project) {
+	if p.cache == nil {
+		p.cache = c.cache
+	}
+	if p.cleanup == nil {
+		p.cleanup = c.cleanup
+	}
+	if p.client == nil {
+		p.client = c.client
+	}
+	if p.ctx == nil {
+		p.ctx = c.ctx
+	}
+	if p.dir == nil {
+		p.dir = c.dir
+	}
+	if p.factory == nil {
+		p.factory = c.factory
+	}
+	if p.jobInfo == nil {
+		p.jobInfo = c.jobInfo
+	}
+	if p.logger == nil {
+		p.logger = c.logger
+	}
+	if p.mappers == nil {
+		p.mappers = c.mappers
+	}
+	if p.plugins == nil {
+		p.plugins = c.plugins
+	}
+	if p.targets == nil {
+		p.targets = c.targets
+	}
+	if p.ui == nil {
+		p.ui = c.ui
+	}
+	if p.vagrantfile == nil {
+		p.vagrantfile = c.vagrantfile
+	}
+
+	// Stored values set by options are merged over those
+	// of the constructed project
+	if c.project != nil {
+		base := proto.Clone(c.project).(*vagrant_server.Project)
+		proto.Merge(base, p.project)
+		p.project = base
+	}
+}
+
+// Set default values for any unset project fields
+func projectDefaults(p *Project) *Project {
+	if p.cache == nil {
+		p.cache = cacher.New()
+	}
+	if p.cleanup == nil {
+		p.cleanup = cleanup.New()
+	}
+	if p.ctx == nil {
+		p.ctx = context.Background()
+	}
+	if p.logger == nil {
+		p.logger = hclog.L()
+	}
+	if p.project == nil {
+		p.project = newProjectProto()
+	}
 
+	return p
+}
+
+// Apply all options to the project
+func applyProjectOptions(p *Project, opts []ProjectOption) (*Project, error) {
+	var err error
 	for _, fn := range opts {
 		if optErr := fn(p); optErr != nil {
 			err = multierror.Append(err, optErr)
@@ -94,6 +184,11 @@ func NewProject(opts ...ProjectOption) (*Project, error) {
 func (p *Project) Init() error {
 	var err error
 
+	// A closed project cannot be reused
+	if p.Closed() {
+		return ErrProjectClosed
+	}
+
 	// If ready then Init was already run
 	if p.ready {
 		return nil
@@ -101,6 +196,9 @@ func (p *Project) Init() error {
 
 	// Configure our logger
 	p.logger = p.logger.ResetNamed("vagrant.core.project")
+	if p.basis != nil {
+		p.logger = p.logger.With(p.basis.loggerFields()...)
+	}
 
 	// If the client isn't set, grab it from the basis
 	if p.client == nil && p.basis != nil {
@@ -326,10 +424,28 @@ func (p *Project) DefaultProvider(opts *core.DefaultProviderOptions) (string, er
 	// wasn't given.)
 	//
 	// 2. If the VAGRANT_DEFAULT_PROVIDER environmental variable is set, it
-	//    takes next priority and will be the provider chosen.
-	defaultProvider := os.Getenv("VAGRANT_DEFAULT_PROVIDER")
+	//    takes next priority and will be the provider chosen. A provider
+	//    set with WithDefaultProvider takes precedence over the variable,
+	//    and the default_provider configuration value is used if neither
+	//    is set (see Basis.requestedProvider). When forced, the requested
+	//    provider must be installed. Otherwise a provider which is not
+	//    available is ignored.
+	defaultProvider, source := p.basis.requestedProvider()
+	if defaultProvider != "" {
+		err := p.basis.checkProvider(defaultProvider, opts.ForceDefault && opts.CheckUsable)
+		if err != nil && opts.ForceDefault {
+			return "", err
+		}
+		if err != nil {
+			logger.Warn("requested default provider is not available, ignoring",
+				"provider", defaultProvider,
+				"source", source,
+				"error", err,
+			)
+		}
+	}
 	if defaultProvider != "" && opts.ForceDefault {
-		logger.Debug("Using forced default provider", "provider", defaultProvider)
+		logDefaultProvider(logger, defaultProvider, source)
 		return defaultProvider, nil
 	}
 
@@ -436,8 +552,7 @@ func (p *Project) DefaultProvider(opts *core.DefaultProviderOptions) (string, er
 	// otherwise excluded, return it now.
 	for _, u := range usableProviders {
 		if u.Name == defaultProvider {
-			logger.Debug("Using default provider as it was found in usable list",
-				"provider", u)
+			logDefaultProvider(logger, u.Name, source)
 			return u.Name, nil
 		}
 	}
@@ -461,8 +576,7 @@ func (p *Project) DefaultProvider(opts *core.DefaultProviderOptions) (string, er
 			if cp == up.Name {
 				for _, pp := range preferredProviders {
 					if cp == pp {
-						logger.Debug("Using preferred provider detected in configuration and usable",
-							"provider", pp)
+						logDefaultProvider(logger, pp, "configured, preferred, and usable")
 						return pp, nil
 					}
 				}
@@ -477,8 +591,7 @@ func (p *Project) DefaultProvider(opts *core.DefaultProviderOptions) (string, er
 	for _, cp := range configProviders {
 		for _, up := range usableProviders {
 			if cp == up.Name {
-				logger.Debug("Using provider detected in configuration and usable",
-					"provider", cp)
+				logDefaultProvider(logger, cp, "configured and usable")
 				return cp, nil
 			}
 		}
@@ -489,8 +602,7 @@ func (p *Project) DefaultProvider(opts *core.DefaultProviderOptions) (string, er
 	for _, pp := range preferredProviders {
 		for _, up := range usableProviders {
 			if pp == up.Name {
-				logger.Debug("Using preffered provider found in usable list",
-					"provider", pp)
+				logDefaultProvider(logger, pp, "preferred and usable")
 				return pp, nil
 			}
 		}
@@ -503,8 +615,7 @@ func (p *Project) DefaultProvider(opts *core.DefaultProviderOptions) (string, er
 	//    example, if you have the VMware provider installed, it will always
 	//    take priority over VirtualBox.
 	if len(usableProviders) > 0 {
-		logger.Debug("Using the first provider from the usable list",
-			"provider", usableProviders[0])
+		logDefaultProvider(logger, usableProviders[0].Name, "highest priority usable")
 		return usableProviders[0].Name, nil
 	}
 
@@ -556,6 +667,34 @@ func (p *Project) Target(nameOrId string, provider string) (core.Target, error)
 	return p.vagrantfile.Target(nameOrId, provider)
 }
 
+// LoadTarget returns the target with the given name or resource
+// id, loading it if it is not already loaded. If the target is
+// referenced by the project but cannot be loaded, the error
+// includes the reason it failed to load.
+func (p *Project) LoadTarget(nameOrId string) (*Target, error) {
+	t, err := p.Target(nameOrId, "")
+	if err != nil {
+		if p.referencesTarget(nameOrId) {
+			return nil, fmt.Errorf("failed to load target %s referenced by project: %w",
+				nameOrId, err)
+		}
+		return nil, err
+	}
+
+	return t.(*Target), nil
+}
+
+// Check if the target is referenced by the stored project
+func (p *Project) referencesTarget(nameOrId string) bool {
+	for _, t := range p.project.Targets {
+		if t.Name == nameOrId || t.ResourceId == nameOrId {
+			return true
+		}
+	}
+
+	return false
+}
+
 // TargetIds implements core.Project
 func (p *Project) TargetIds() ([]string, error) {
 	var ids []string
@@ -579,6 +718,51 @@ func (p *Project) TargetNames() ([]string, error) {
 	return names, nil
 }
 
+// TargetByIndex returns the target at the given index. Targets
+// are ordered as they are declared in the Vagrantfile followed by
+// any stored targets which are not declared.
+func (p *Project) TargetByIndex(i int) (core.Target, error) {
+	names, err := p.orderedTargetNames()
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= len(names) {
+		return nil, fmt.Errorf("target index %d is out of range, project has %d targets",
+			i, len(names))
+	}
+
+	return p.Target(names[i], "")
+}
+
+// Names of all targets within the project without duplicates.
+// Targets declared in the Vagrantfile are first, in the order
+// they are declared, followed by stored targets.
+func (p *Project) orderedTargetNames() ([]string, error) {
+	var declared []string
+	if p.vagrantfile != nil {
+		var err error
+		if declared, err = p.vagrantfile.TargetNames(); err != nil {
+			return nil, err
+		}
+	}
+	stored, err := p.TargetNames()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	seen := map[string]struct{}{}
+	for _, n := range append(declared, stored...) {
+		if _, ok := seen[n]; ok || n == "" {
+			continue
+		}
+		seen[n] = struct{}{}
+		names = append(names, n)
+	}
+
+	return names, nil
+}
+
 // Tmp implements core.Project
 func (p *Project) Tmp() (path path.Path, err error) {
 	return p.dir.TempDir(), nil
@@ -591,7 +775,7 @@ func (p *Project) UI() (terminal.UI, error) {
 
 // Targets
 func (p *Project) Targets() ([]core.Target, error) {
-	names, err := p.TargetNames()
+	names, err := p.orderedTargetNames()
 	if err != nil {
 		return nil, err
 	}
@@ -607,6 +791,90 @@ func (p *Project) Targets() ([]core.Target, error) {
 	return targets, nil
 }
 
+// RemoveTarget removes the target from the project. The target is
+// closed and deleted from the server. Removal is refused if the
+// target's machine still exists unless the removal is forced.
+func (p *Project) RemoveTarget(nameOrId string, opts ...RemoveOption) (err error) {
+	ro := &removeOptions{}
+	for _, opt := range opts {
+		opt(ro)
+	}
+
+	var ref *vagrant_plugin_sdk.Ref_Target
+	for _, t := range p.project.Targets {
+		if t.Name == nameOrId || t.ResourceId == nameOrId {
+			ref = t
+			break
+		}
+	}
+	if ref == nil {
+		return status.Errorf(codes.NotFound,
+			"target %q not found in project %s", nameOrId, p.project.Name)
+	}
+
+	t, err := p.factory.NewTarget(
+		WithProject(p),
+		WithTargetRef(ref),
+	)
+	if err != nil {
+		return err
+	}
+
+	if t.Exists() && !ro.force {
+		return fmt.Errorf("cannot remove target %s, machine still exists", ref.Name)
+	}
+
+	p.logger.Trace("removing target from project",
+		"target", ref.Name,
+		"data-dir", ro.dataDir,
+	)
+
+	// Capture the directories before closing the target
+	dirs := []path.Path{}
+	if ro.dataDir && t.dir != nil {
+		dirs = append(dirs, t.dir.CacheDir(), t.dir.ConfigDir(),
+			t.dir.DataDir(), t.dir.TempDir())
+	}
+
+	// Close the target prior to deletion so any closers
+	// which persist the target are complete
+	if err = t.Close(); err != nil {
+		return err
+	}
+
+	if _, err = p.Client().DeleteTarget(p.ctx,
+		&vagrant_server.DeleteTargetRequest{Target: ref},
+	); err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if rerr := os.RemoveAll(dir.String()); rerr != nil {
+			err = multierror.Append(err, rerr)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if p.vagrantfile != nil {
+		p.vagrantfile.forgetTarget(ref.Name, ref.ResourceId)
+	}
+
+	p.m.Lock()
+	delete(p.targets, ref.Name)
+	targets := []*vagrant_plugin_sdk.Ref_Target{}
+	for _, pt := range p.project.Targets {
+		if pt.ResourceId != ref.ResourceId {
+			targets = append(targets, pt)
+		}
+	}
+	p.project.Targets = targets
+	p.m.Unlock()
+
+	return p.Save()
+}
+
 // Custom name defined for this project
 func (p *Project) Name() string {
 	return p.project.Name
@@ -631,48 +899,27 @@ func (p *Project) Ref() interface{} {
 	}
 }
 
-func (p *Project) Run(ctx context.Context, task *vagrant_server.Job_CommandOp) (err error) {
-	p.logger.Debug("running new command",
-		"command", task)
-
-	cmd, err := p.basis.component(
-		ctx, component.CommandType, task.Component.Name)
-	if err != nil {
-		return err
-	}
-
-	fn := cmd.Value.(component.Command).ExecuteFunc(
-		strings.Split(task.Command, " "))
-	result, err := p.callDynamicFunc(ctx, p.logger, fn, (*int32)(nil),
-		argmapper.Typed(ctx, task.CliArgs, p.jobInfo),
-		argmapper.ConverterFunc(cmd.mappers...),
-	)
-
-	p.logger.Warn("completed running command from project", "result", result)
-
-	if err != nil || result == nil || result.(int32) != 0 {
-		p.logger.Error("failed to execute command",
-			"type", component.CommandType,
-			"name", task.Component.Name,
-			"result", result,
-			"error", err,
-		)
-
-		cmdErr := &runError{}
-		if err != nil {
-			cmdErr.err = err
-			if st, ok := status.FromError(err); ok {
-				cmdErr.status = st.Proto()
-			}
-		}
-		if result != nil {
-			cmdErr.exitCode = result.(int32)
-		}
-
-		return cmdErr
-	}
+func (p *Project) Run(ctx context.Context, task *vagrant_server.Job_CommandOp) (warnings []string, err error) {
+	return p.basis.wrapRun(p.run)(ctx, task)
+}
 
-	return
+// Run the task's command component
+func (p *Project) run(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+	load, release := p.basis.commandLoader()
+	defer release()
+
+	return p.basis.runCommandIn(ctx, task, &commandRun{
+		scope:     p,
+		ui:        p.ui,
+		logger:    p.logger,
+		cmdLogger: p.commandLogger(),
+		jobInfo:   p.jobInfo,
+		project:   p,
+		typed:     []interface{}{ctx, task.CliArgs, p.jobInfo},
+		call:      p.callDynamicFunc,
+		load:      load,
+		rerun:     p.Run,
+	})
 }
 
 // Set project specific seeds
@@ -701,9 +948,21 @@ func (p *Project) Closer(c func() error) {
 func (p *Project) Close() (err error) {
 	p.logger.Trace("closing project")
 
+	p.m.Lock()
+	p.closed = true
+	p.m.Unlock()
+
 	return p.cleanup.Close()
 }
 
+// Closed returns if the project has been closed
+func (p *Project) Closed() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.closed
+}
+
 // Saves the project to the db
 func (p *Project) Save() error {
 	p.m.Lock()
@@ -743,7 +1002,7 @@ func (p *Project) Save() error {
 			"error", err,
 		)
 
-		return err
+		return newServerError(err, "save project", p.project.ResourceId)
 	}
 
 	p.project = result.Project
@@ -778,7 +1037,7 @@ func (p *Project) scrubTargets() (err error) {
 			// Try and load the target so we can destroy it. If that fails,
 			// then we just delete it directly via the client
 			var target *Target
-			raw, ok := p.factory.cache.Fetch(resp.Target.ResourceId)
+			raw, ok := p.factory.fetch(resp.Target.ResourceId)
 			if ok {
 				target = raw.(*Target)
 			} else {
@@ -813,6 +1072,9 @@ func (p *Project) scrubTargets() (err error) {
 				if err != nil {
 					return err
 				}
+				if p.vagrantfile != nil {
+					p.vagrantfile.forgetTarget(t.Name, t.ResourceId)
+				}
 			} else {
 				err = target.Destroy()
 				if err != nil {
@@ -857,14 +1119,29 @@ func (p *Project) InitTargets() (err error) {
 		"targets", names,
 	)
 
+	// Targets already known to the project are fetched using a
+	// single request and provided to the factory, so they are not
+	// looked up individually. If they cannot be fetched together,
+	// their resource ids allow the factory to skip the lookup it
+	// would otherwise perform prior to initialization. Targets not
+	// yet known are loaded by name.
+	refs := p.targetRefs()
+	known, err := p.loadTargets(refs)
+	if err != nil {
+		return
+	}
+
 	// Use the factory to create or load the targets
 	// so they are all valid in the database
 	for _, name := range names {
 		p.logger.Trace("loading new target from factory during init", "name", name)
-		t, err := p.factory.NewTarget(
-			WithTargetName(name),
-			WithProject(p),
-		)
+		opts := []TargetOption{WithTargetName(name), WithProject(p)}
+		if st, ok := known[name]; ok {
+			opts = []TargetOption{WithTargetData(st), WithProject(p)}
+		} else if ref, ok := refs[name]; ok {
+			opts = append(opts, WithTargetRef(ref))
+		}
+		t, err := p.factory.NewTarget(opts...)
 		if err != nil {
 			p.logger.Error("failed to load target from factory", "name", name)
 			return err
@@ -876,6 +1153,61 @@ func (p *Project) InitTargets() (err error) {
 	return p.Reload()
 }
 
+// Returns the references of targets known to the
+// project keyed by target name
+func (p *Project) targetRefs() map[string]*vagrant_plugin_sdk.Ref_Target {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	refs := make(map[string]*vagrant_plugin_sdk.Ref_Target, len(p.project.Targets))
+	for _, t := range p.project.Targets {
+		if t.Name != "" && t.ResourceId != "" {
+			refs[t.Name] = t
+		}
+	}
+
+	return refs
+}
+
+// Fetch the stored data of the referenced targets using a single
+// request, keyed by target name. If the server does not support
+// fetching targets together, or a target no longer exists, nothing
+// is returned and the targets are loaded individually.
+func (p *Project) loadTargets(
+	refs map[string]*vagrant_plugin_sdk.Ref_Target,
+) (map[string]*vagrant_server.Target, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	req := &vagrant_server.GetTargetsRequest{
+		Targets: make([]*vagrant_plugin_sdk.Ref_Target, 0, len(refs)),
+	}
+	for _, ref := range refs {
+		req.Targets = append(req.Targets, ref)
+	}
+
+	resp, err := p.Client().GetTargets(p.ctx, req)
+	if err != nil {
+		if code := status.Code(err); code == codes.Unimplemented || code == codes.NotFound {
+			p.logger.Trace("failed to fetch project targets, loading individually",
+				"error", err,
+			)
+
+			return nil, nil
+		}
+
+		return nil, newServerError(err, "get targets", p.project.ResourceId)
+	}
+
+	result := make(map[string]*vagrant_server.Target, len(resp.Targets))
+	for _, t := range resp.Targets {
+		result[t.Name] = t
+	}
+
+	return result, nil
+}
+
 // Reload the project data
 func (p *Project) Reload() (err error) {
 	p.m.Lock()
@@ -892,7 +1224,7 @@ func (p *Project) Reload() (err error) {
 	)
 
 	if err != nil {
-		return
+		return newServerError(err, "find project", p.project.ResourceId)
 	}
 
 	p.project = result.Project
@@ -955,7 +1287,7 @@ func (p *Project) callDynamicFunc(
 	args ...argmapper.Arg, // list of argmapper arguments
 ) (interface{}, error) {
 	// ensure our UI status is closed after every call in case it is used
-	defer p.ui.Status().Close()
+	defer p.basis.uiStatus.acquire(p.ui)()
 
 	return p.basis.callDynamicFunc(ctx, log, f, expectedType, args...)
 }
@@ -965,7 +1297,7 @@ func (p *Project) execHook(
 	log hclog.Logger,
 	h *config.Hook,
 ) error {
-	return execHook(ctx, p, log, h)
+	return execHook(ctx, p.basis, p, log, h)
 }
 
 func (p *Project) doOperation(
@@ -973,7 +1305,39 @@ func (p *Project) doOperation(
 	log hclog.Logger,
 	op operation,
 ) (interface{}, proto.Message, error) {
-	return doOperation(ctx, log, p, op)
+	defer p.basis.projects.pin(p)()
+
+	return p.basis.wrapOperation(p, op)(ctx, log)
+}
+
+func (p *Project) retryPolicy(op operation) *operationRetry {
+	return p.basis.retryPolicy(op)
+}
+
+func (p *Project) lifecycle() *eventStream {
+	return p.basis.lifecycle()
+}
+
+// RemoveOption is used to set options for RemoveTarget
+type RemoveOption func(*removeOptions)
+
+type removeOptions struct {
+	dataDir bool // remove the target data directories
+	force   bool // remove even if the machine exists
+}
+
+// WithRemoveDataDir removes the target's data directories
+func WithRemoveDataDir() RemoveOption {
+	return func(o *removeOptions) {
+		o.dataDir = true
+	}
+}
+
+// WithRemoveForce removes the target even if its machine exists
+func WithRemoveForce() RemoveOption {
+	return func(o *removeOptions) {
+		o.force = true
+	}
 }
 
 // ProjectOption is used to set options for LoadProject
@@ -1007,6 +1371,56 @@ func WithProjectName(name string) ProjectOption {
 	}
 }
 
+// WithProjectResourceId loads the project from the server using
+// its resource id. The basis must be set before this option is
+// applied and the project must belong to the basis.
+func WithProjectResourceId(rid string) ProjectOption {
+	return func(p *Project) (err error) {
+		if rid == "" {
+			return errors.New("project resource id cannot be empty")
+		}
+		if p.basis == nil {
+			return errors.New("basis must be set before loading project by resource id")
+		}
+
+		result, err := p.basis.Client().GetProject(p.basis.ctx,
+			&vagrant_server.GetProjectRequest{
+				Project: &vagrant_plugin_sdk.Ref_Project{
+					ResourceId: rid,
+				},
+			},
+		)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return status.Errorf(codes.NotFound,
+					"requested project is not found (resource-id: %s)", rid)
+			}
+			return newServerError(err, "get project", rid)
+		}
+
+		brid := p.basis.basis.ResourceId
+		if result.Project.Basis == nil || result.Project.Basis.ResourceId != brid {
+			return fmt.Errorf("project %s does not belong to basis %s", rid, brid)
+		}
+
+		p.project = result.Project
+		return
+	}
+}
+
+// WithProjectUI sets the UI used by the project instead of the
+// basis UI. Targets within the project will also use this UI
+// unless they are given their own.
+func WithProjectUI(ui terminal.UI) ProjectOption {
+	return func(p *Project) (err error) {
+		if ui == nil {
+			return errors.New("ui cannot be nil")
+		}
+		p.ui = ui
+		return
+	}
+}
+
 // WithBasisRef is used to load or initialize the project
 func WithProjectRef(r *vagrant_plugin_sdk.Ref_Project) ProjectOption {
 	return func(p *Project) (err error) {
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"container/list"
+	"sync"
+)
+
+// projectCache tracks the projects loaded by the basis and closes
+// the least recently used projects when more than the maximum are
+// loaded. Closing a project removes it from the factory cache, so
+// requesting it again reloads it. Projects with in-flight operations
+// are pinned and are not closed until the operations complete.
+type projectCache struct {
+	entries map[*Project]*list.Element // cache entries for loaded projects
+	max     int                        // maximum loaded projects, unlimited if zero
+	onEvict func(*Project)             // called after a project is evicted
+	order   *list.List                 // loaded projects, most recently used first
+	pinned  map[*Project]int           // number of in-flight operations for projects
+
+	m sync.Mutex
+}
+
+func newProjectCache() *projectCache {
+	return &projectCache{
+		entries: map[*Project]*list.Element{},
+		order:   list.New(),
+		pinned:  map[*Project]int{},
+	}
+}
+
+// Mark the project as used, evicting the least recently used
+// projects if the maximum has been exceeded
+func (c *projectCache) touch(p *Project) {
+	c.m.Lock()
+	if c.max < 1 {
+		c.m.Unlock()
+		return
+	}
+	if e, ok := c.entries[p]; ok {
+		c.order.MoveToFront(e)
+	} else {
+		c.entries[p] = c.order.PushFront(p)
+	}
+	evicted := c.evictable()
+	c.m.Unlock()
+
+	c.evict(evicted)
+}
+
+// Remove the project from the cache
+func (c *projectCache) remove(p *Project) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if e, ok := c.entries[p]; ok {
+		c.order.Remove(e)
+		delete(c.entries, p)
+	}
+}
+
+// Pin the project so it is not evicted while an operation is in
+// flight. The returned function must be called when the operation
+// has completed.
+func (c *projectCache) pin(p *Project) func() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.max < 1 {
+		return func() {}
+	}
+	c.pinned[p]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { c.unpin(p) })
+	}
+}
+
+func (c *projectCache) unpin(p *Project) {
+	c.m.Lock()
+	c.pinned[p]--
+	if c.pinned[p] < 1 {
+		delete(c.pinned, p)
+	}
+	evicted := c.evictable()
+	c.m.Unlock()
+
+	c.evict(evicted)
+}
+
+// Remove least recently used projects which are not pinned until
+// the maximum is no longer exceeded. The most recently used project
+// is never removed. The removed projects are returned so they can
+// be closed once the lock is released.
+func (c *projectCache) evictable() []*Project {
+	evicted := []*Project{}
+	for e := c.order.Back(); e != c.order.Front() && c.order.Len() > c.max; {
+		prev := e.Prev()
+		p := e.Value.(*Project)
+		if c.pinned[p] == 0 {
+			c.order.Remove(e)
+			delete(c.entries, p)
+			evicted = append(evicted, p)
+		}
+		e = prev
+	}
+
+	return evicted
+}
+
+// Close the evicted projects
+func (c *projectCache) evict(evicted []*Project) {
+	for _, p := range evicted {
+		if err := p.Close(); err != nil {
+			p.logger.Warn("failed to close evicted project",
+				"error", err,
+			)
+		}
+		if c.onEvict != nil {
+			c.onEvict(p)
+		}
+	}
+}
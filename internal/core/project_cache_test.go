@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisMaxLoadedProjects(t *testing.T) {
+	_, err := NewBasis(context.Background(), WithMaxLoadedProjects(0))
+	require.Error(t, err)
+
+	evicted := []string{}
+	b := TestBasis(t,
+		WithMaxLoadedProjects(1),
+		WithProjectEvicted(func(p *Project) { evicted = append(evicted, p.project.Name) }),
+	)
+
+	load := func(ref *vagrant_plugin_sdk.Ref_Project) *Project {
+		p, err := b.factory.NewProject(WithBasis(b), WithProjectRef(ref))
+		require.NoError(t, err)
+		return p
+	}
+	newRef := func() *vagrant_plugin_sdk.Ref_Project {
+		path := t.TempDir()
+		return &vagrant_plugin_sdk.Ref_Project{
+			Basis: b.Ref().(*vagrant_plugin_sdk.Ref_Basis),
+			Name:  filepath.Base(path),
+			Path:  path,
+		}
+	}
+
+	first := load(newRef())
+	second := load(newRef())
+	require.Equal(t, []string{first.project.Name}, evicted)
+	require.True(t, first.Closed())
+
+	// Pinned projects are not evicted
+	unpin := b.projects.pin(second)
+	third := load(newRef())
+	require.Len(t, evicted, 1)
+	require.False(t, second.Closed())
+
+	// Once unpinned the least recently used project is evicted
+	unpin()
+	require.Equal(t, []string{first.project.Name, second.project.Name}, evicted)
+	require.True(t, second.Closed())
+	require.False(t, third.Closed())
+
+	// Evicted projects are reloaded when requested
+	reloaded := load(first.Ref().(*vagrant_plugin_sdk.Ref_Project))
+	require.False(t, reloaded.Closed())
+	require.NotSame(t, first, reloaded)
+	require.Equal(t, first.project.ResourceId, reloaded.project.ResourceId)
+	require.True(t, third.Closed())
+}
@@ -4,11 +4,21 @@
 package core
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/helper/types"
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
 	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+	"github.com/hashicorp/vagrant/internal/serverclient"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func projectTargets(t *testing.T, project *Project, numTargets int) (targets []*Target) {
@@ -33,6 +43,75 @@ func TestNewProject(t *testing.T) {
 	}
 }
 
+func TestProjectClosed(t *testing.T) {
+	tp := TestMinimalProject(t)
+	b := tp.basis
+	id := tp.project.ResourceId
+
+	require.False(t, tp.Closed())
+	require.NoError(t, tp.Close())
+	require.True(t, tp.Closed())
+	require.ErrorIs(t, tp.Init(), ErrProjectClosed)
+
+	// Simulate a stale cache entry left by a closed project
+	b.factory.cache.Register(id, tp)
+
+	p, err := b.factory.NewProject(
+		WithBasis(b),
+		WithProjectRef(tp.Ref().(*vagrant_plugin_sdk.Ref_Project)),
+	)
+	require.NoError(t, err)
+	require.False(t, p.Closed())
+	require.NotSame(t, tp, p)
+
+	cached, ok := b.factory.cache.Fetch(id)
+	require.True(t, ok)
+	require.Same(t, p, cached)
+}
+
+func TestProjectConcurrentLoad(t *testing.T) {
+	tp := TestMinimalProject(t)
+	b := tp.basis
+	id := tp.project.ResourceId
+
+	// Simulate another load of the project completing first
+	p, err := NewProject(
+		WithBasis(b),
+		WithProjectRef(tp.Ref().(*vagrant_plugin_sdk.Ref_Project)),
+	)
+	require.NoError(t, err)
+	existing, ok := b.factory.register(id, p)
+	require.False(t, ok)
+	require.Same(t, tp, existing)
+
+	// Removing an instance which is not cached leaves the entry
+	b.factory.unregister(id, p)
+	cached, ok := b.factory.fetch(id)
+	require.True(t, ok)
+	require.Same(t, tp, cached)
+
+	var wg sync.WaitGroup
+	results := make([]*Project, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = b.factory.NewProject(
+				WithBasis(b),
+				WithProjectRef(tp.Ref().(*vagrant_plugin_sdk.Ref_Project)),
+			)
+		}(i)
+	}
+	wg.Wait()
+	for _, r := range results {
+		require.Same(t, tp, r)
+	}
+
+	require.NoError(t, tp.Close())
+	_, ok = b.factory.fetch(id)
+	require.False(t, ok)
+}
+
 func TestProjectGetTarget(t *testing.T) {
 	tp := TestMinimalProject(t)
 	// Add targets to project
@@ -112,3 +191,269 @@ func TestProjectGetTargets(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, targets, 3)
 }
+
+func TestProjectTargetByIndex(t *testing.T) {
+	tp := TestMinimalProject(t)
+	projectTargets(t, tp, 3)
+
+	targets, err := tp.Targets()
+	require.NoError(t, err)
+	for i, expected := range targets {
+		target, err := tp.TargetByIndex(i)
+		require.NoError(t, err)
+		require.Equal(t, expected.(*Target).target.ResourceId, target.(*Target).target.ResourceId)
+	}
+
+	_, err = tp.TargetByIndex(3)
+	require.Error(t, err)
+	_, err = tp.TargetByIndex(-1)
+	require.Error(t, err)
+}
+
+func TestProjectLoadTarget(t *testing.T) {
+	tp := TestMinimalProject(t)
+	projectTargets(t, tp, 1)
+
+	target, err := tp.LoadTarget("target-0")
+	require.NoError(t, err)
+	require.Equal(t, "target-0", target.target.Name)
+
+	_, err = tp.LoadTarget("missing")
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "referenced by project")
+
+	// Targets referenced by the project report why they failed to load
+	tp.project.Targets = append(tp.project.Targets,
+		&vagrant_plugin_sdk.Ref_Target{Name: "ghost", ResourceId: "ghost-id"})
+	_, err = tp.LoadTarget("ghost")
+	require.ErrorContains(t, err, "failed to load target ghost referenced by project")
+}
+
+func TestProjectRemoveTarget(t *testing.T) {
+	tp := TestMinimalProject(t)
+	projectTargets(t, tp, 2)
+
+	// Target machines exist so removal requires force
+	require.Error(t, tp.RemoveTarget("target-0"))
+
+	target, err := tp.factory.NewTarget(WithProject(tp),
+		WithTargetRef(&vagrant_plugin_sdk.Ref_Target{ResourceId: "id-0"}))
+	require.NoError(t, err)
+	dataDir := target.dir.DataDir().String()
+	_, err = os.Stat(dataDir)
+	require.NoError(t, err)
+
+	require.NoError(t, tp.RemoveTarget("target-0", WithRemoveForce(), WithRemoveDataDir()))
+	_, err = os.Stat(dataDir)
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, tp.RemoveTarget("id-1", WithRemoveForce()))
+
+	require.NoError(t, tp.Reload())
+	names, err := tp.TargetNames()
+	require.NoError(t, err)
+	require.Empty(t, names)
+
+	require.Error(t, tp.RemoveTarget("target-0"))
+}
+
+func TestProjectTargetRefs(t *testing.T) {
+	tp := TestMinimalProject(t)
+	require.Empty(t, tp.targetRefs())
+
+	projectTargets(t, tp, 2)
+
+	refs := tp.targetRefs()
+	require.Len(t, refs, 2)
+	require.Equal(t, "id-0", refs["target-0"].ResourceId)
+	require.Equal(t, "id-1", refs["target-1"].ResourceId)
+}
+
+// Client which counts the requests made to load targets
+type targetCountingClient struct {
+	vagrant_server.VagrantClient
+
+	batch bool
+	calls map[string]int
+	m     sync.Mutex
+}
+
+func (c *targetCountingClient) count(name string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.calls[name]++
+}
+
+func (c *targetCountingClient) requests() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	total := 0
+	for _, n := range c.calls {
+		total += n
+	}
+	return total
+}
+
+func (c *targetCountingClient) FindTarget(
+	ctx context.Context,
+	in *vagrant_server.FindTargetRequest,
+	opts ...grpc.CallOption,
+) (*vagrant_server.FindTargetResponse, error) {
+	c.count("FindTarget")
+	return c.VagrantClient.FindTarget(ctx, in, opts...)
+}
+
+func (c *targetCountingClient) GetTarget(
+	ctx context.Context,
+	in *vagrant_server.GetTargetRequest,
+	opts ...grpc.CallOption,
+) (*vagrant_server.GetTargetResponse, error) {
+	c.count("GetTarget")
+	return c.VagrantClient.GetTarget(ctx, in, opts...)
+}
+
+func (c *targetCountingClient) GetTargets(
+	ctx context.Context,
+	in *vagrant_server.GetTargetsRequest,
+	opts ...grpc.CallOption,
+) (*vagrant_server.GetTargetsResponse, error) {
+	c.count("GetTargets")
+	if !c.batch {
+		return nil, status.Error(codes.Unimplemented, "unknown method GetTargets")
+	}
+	return c.VagrantClient.GetTargets(ctx, in, opts...)
+}
+
+func TestProjectInitTargets(t *testing.T) {
+	for _, batch := range []bool{true, false} {
+		batch := batch
+		t.Run(fmt.Sprintf("batch=%t", batch), func(t *testing.T) {
+			tp := TestMinimalProject(t)
+			vms := []interface{}{}
+			for _, target := range projectTargets(t, tp, 3) {
+				if target == nil {
+					continue
+				}
+				vms = append(vms, types.Symbol(target.target.Name))
+				// Close the target so it is no longer cached
+				require.NoError(t, target.Close())
+			}
+			tp.vagrantfile.root.Data["vm"] = &component.ConfigData{
+				Data: map[string]interface{}{"__defined_vm_keys": vms},
+			}
+
+			client := &targetCountingClient{
+				VagrantClient: tp.client.VagrantClient,
+				batch:         batch,
+				calls:         map[string]int{},
+			}
+			counting := &serverclient.VagrantClient{VagrantClient: client}
+			tp.client = counting
+			tp.factory.client = counting
+
+			require.NoError(t, tp.InitTargets())
+			for i := 0; i < 3; i++ {
+				_, ok := tp.factory.fetch(fmt.Sprintf("id-%d", i))
+				require.True(t, ok)
+			}
+
+			if batch {
+				// All known targets are fetched with one request
+				require.Equal(t, map[string]int{"GetTargets": 1}, client.calls)
+				require.Equal(t, 1, client.requests())
+			} else {
+				// Each known target is looked up individually
+				require.Equal(t, 3, client.calls["FindTarget"])
+				require.Zero(t, client.calls["GetTarget"])
+				require.Equal(t, 4, client.requests())
+			}
+		})
+	}
+}
+
+func TestProjectConstructor(t *testing.T) {
+	ui := &testStyleUI{}
+	b := TestBasis(t, WithProjectConstructor(func(*Basis) *Project {
+		return &Project{
+			ui:      ui,
+			project: &vagrant_server.Project{Name: "constructed", Path: "/constructed"},
+		}
+	}))
+
+	// Options are applied once
+	applied := 0
+	counter := func(*Project) error {
+		applied++
+		return nil
+	}
+
+	p, err := NewProject(WithBasis(b), WithProjectName("custom"), counter)
+	require.NoError(t, err)
+	require.Equal(t, 1, applied)
+	require.Same(t, ui, p.ui)
+	require.Same(t, b, p.basis)
+	require.Equal(t, "custom", p.project.Name)
+	require.Equal(t, "/constructed", p.project.Path)
+	require.NotNil(t, p.cache)
+
+	// Values set by options take precedence
+	optUI := &testStyleUI{}
+	p, err = NewProject(WithBasis(b), WithProjectUI(optUI))
+	require.NoError(t, err)
+	require.Same(t, optUI, p.ui)
+
+	_, err = NewBasis(b.ctx, WithProjectConstructor(nil))
+	require.Error(t, err)
+}
+
+func TestProjectUI(t *testing.T) {
+	b := TestBasis(t)
+	ui := &testStyleUI{}
+
+	p, err := b.factory.NewProject(
+		WithBasis(b),
+		WithProjectRef(&vagrant_plugin_sdk.Ref_Project{
+			Name: "ui-project",
+			Path: t.TempDir(),
+		}),
+		WithProjectUI(ui),
+	)
+	require.NoError(t, err)
+	pui, err := p.UI()
+	require.NoError(t, err)
+	require.Same(t, ui, pui)
+	require.NotSame(t, b.ui, pui)
+
+	_, err = NewProject(WithBasis(b), WithProjectUI(nil))
+	require.Error(t, err)
+}
+
+func TestProjectResourceId(t *testing.T) {
+	b := TestBasis(t)
+	p, err := b.factory.NewProject(
+		WithBasis(b),
+		WithProjectRef(&vagrant_plugin_sdk.Ref_Project{
+			Name: "by-id",
+			Path: t.TempDir(),
+		}),
+	)
+	require.NoError(t, err)
+	rid := p.project.ResourceId
+	require.NotEmpty(t, rid)
+
+	loaded, err := b.factory.NewProject(WithBasis(b), WithProjectResourceId(rid))
+	require.NoError(t, err)
+	require.Same(t, p, loaded)
+
+	_, err = NewProject(WithBasis(b), WithProjectResourceId("unknown"))
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = NewProject(WithProjectResourceId(rid))
+	require.Error(t, err)
+
+	// Projects from another basis are rejected
+	other := TestBasis(t, WithClient(b.client))
+	_, err = NewProject(WithBasis(other), WithProjectResourceId(rid))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not belong")
+}
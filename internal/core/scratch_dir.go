@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// scratchDirs tracks the scratch directories of a basis which
+// have not been removed
+type scratchDirs struct {
+	dirs   map[string]struct{} // directories to be removed
+	logger hclog.Logger        // logger for removal failures
+	once   sync.Once           // registers the basis closer
+	stop   chan struct{}       // closed when the basis is closed
+
+	m sync.Mutex
+}
+
+func newScratchDirs() *scratchDirs {
+	return &scratchDirs{
+		dirs: map[string]struct{}{},
+		stop: make(chan struct{}),
+	}
+}
+
+// Track the directory for removal
+func (s *scratchDirs) add(dir string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.dirs[dir] = struct{}{}
+}
+
+// Remove the directory if it has not already been removed
+func (s *scratchDirs) remove(dir string) error {
+	s.m.Lock()
+	_, ok := s.dirs[dir]
+	delete(s.dirs, dir)
+	s.m.Unlock()
+
+	if !ok {
+		return nil
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		s.logger.Warn("failed to remove scratch directory",
+			"path", dir,
+			"error", err,
+		)
+		return err
+	}
+
+	return nil
+}
+
+// Remove all remaining directories and stop watching for
+// contexts to be done
+func (s *scratchDirs) close() (err error) {
+	close(s.stop)
+
+	s.m.Lock()
+	dirs := make([]string, 0, len(s.dirs))
+	for dir := range s.dirs {
+		dirs = append(dirs, dir)
+	}
+	s.m.Unlock()
+
+	for _, dir := range dirs {
+		if rerr := s.remove(dir); rerr != nil {
+			err = rerr
+		}
+	}
+
+	return
+}
+
+// ScratchDir creates a temporary directory within the basis temp
+// directory for use by an operation. The directory is removed when
+// the context is done or the basis is closed, whichever happens
+// first, so cancelled operations do not leave large artifacts
+// behind until the basis is closed.
+func (b *Basis) ScratchDir(ctx context.Context) (string, error) {
+	dir, err := os.MkdirTemp(b.dir.TempDir().String(), "scratch")
+	if err != nil {
+		return "", err
+	}
+
+	s := b.scratch
+	s.once.Do(func() {
+		s.logger = b.logger
+		b.Closer(s.close)
+	})
+	s.add(dir)
+
+	// Contexts which are never done are only removed on close
+	if ctx.Done() == nil {
+		return dir, nil
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.remove(dir)
+		case <-s.stop:
+		}
+	}()
+
+	return dir, nil
+}
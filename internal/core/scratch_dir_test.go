@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasisScratchDir(t *testing.T) {
+	b := TestBasis(t)
+
+	// Removed when the context is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	dir, err := b.ScratchDir(ctx)
+	require.NoError(t, err)
+	require.DirExists(t, dir)
+	cancel()
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(dir)
+		return os.IsNotExist(err)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// No longer tracked once removed
+	b.scratch.m.Lock()
+	require.NotContains(t, b.scratch.dirs, dir)
+	b.scratch.m.Unlock()
+
+	// Removed when the basis is closed
+	dir, err = b.ScratchDir(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "artifact"), []byte("data"), 0644))
+	require.NoError(t, b.Close())
+	require.NoDirExists(t, dir)
+}
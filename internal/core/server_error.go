@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serverError adds context to an error returned by the Vagrant
+// server. The gRPC status of the original error is retained so
+// callers can continue to inspect the status code.
+type serverError struct {
+	op  string // operation which failed
+	id  string // resource id the operation was for
+	err error  // error returned by the server
+}
+
+// Wrap the server error with the operation and resource id. If
+// the error is nil, nil is returned.
+func newServerError(err error, op, id string) error {
+	if err == nil {
+		return nil
+	}
+
+	return &serverError{op: op, id: id, err: err}
+}
+
+// Error implements error
+func (e *serverError) Error() string {
+	msg := e.err.Error()
+	if s, ok := status.FromError(e.err); ok {
+		msg = s.Message()
+	}
+	if e.id == "" {
+		return fmt.Sprintf("failed to %s: %s", e.op, msg)
+	}
+
+	return fmt.Sprintf("failed to %s (resource-id: %s): %s", e.op, e.id, msg)
+}
+
+// Unwrap returns the error returned by the server
+func (e *serverError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus returns the status of the server error with the
+// added context included in the message
+func (e *serverError) GRPCStatus() *status.Status {
+	s, ok := status.FromError(e.err)
+	if !ok {
+		return status.New(codes.Unknown, e.Error())
+	}
+	p := s.Proto()
+	p.Message = e.Error()
+
+	return status.FromProto(p)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerError(t *testing.T) {
+	require.NoError(t, newServerError(nil, "find basis", "id"))
+
+	orig := status.Error(codes.NotFound, "basis not found")
+	err := newServerError(orig, "find basis", "abc")
+	require.Equal(t, "failed to find basis (resource-id: abc): basis not found", err.Error())
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.ErrorIs(t, err, orig)
+
+	// Status is retained when combined with other errors
+	merr := multierror.Append(errors.New("other"), err)
+	require.Equal(t, codes.NotFound, status.Code(merr))
+
+	// Errors without a status are unknown
+	err = newServerError(errors.New("broken"), "save basis", "")
+	require.Equal(t, "failed to save basis: broken", err.Error())
+	require.Equal(t, codes.Unknown, status.Code(err))
+}
+
+func TestBasisReloadServerError(t *testing.T) {
+	b := TestBasis(t)
+	b.basis = &vagrant_server.Basis{ResourceId: "missing"}
+
+	err := b.Reload()
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Contains(t, err.Error(), "failed to find basis (resource-id: missing)")
+}
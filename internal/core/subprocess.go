@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// SubprocessResult is the result of running a host command
+type SubprocessResult struct {
+	ExitCode int    // exit code of the command
+	Stdout   string // captured standard output
+	Stderr   string // captured standard error
+}
+
+// SubprocessOption is used to set options for Subprocess
+type SubprocessOption func(*subprocess)
+
+type subprocess struct {
+	dir    string            // working directory of the command
+	env    map[string]string // additional environment variables
+	logger hclog.Logger      // logger for the command
+	shell  bool              // run the command using the host shell
+	ui     terminal.UI       // UI receiving command output
+}
+
+// SubprocessDir sets the working directory of the command
+func SubprocessDir(dir string) SubprocessOption {
+	return func(s *subprocess) {
+		s.dir = dir
+	}
+}
+
+// SubprocessEnv sets additional environment variables for the
+// command. These take precedence over the basis environment.
+func SubprocessEnv(env map[string]string) SubprocessOption {
+	return func(s *subprocess) {
+		for k, v := range env {
+			s.env[k] = v
+		}
+	}
+}
+
+// SubprocessLogger sets the logger used for the command
+func SubprocessLogger(log hclog.Logger) SubprocessOption {
+	return func(s *subprocess) {
+		s.logger = log
+	}
+}
+
+// SubprocessShell runs the command using the host shell. The
+// command arguments are joined and passed to the shell as a
+// single command line.
+func SubprocessShell() SubprocessOption {
+	return func(s *subprocess) {
+		s.shell = true
+	}
+}
+
+// SubprocessUI sets the UI receiving the command output. If
+// unset, output is sent to the basis UI.
+func SubprocessUI(ui terminal.UI) SubprocessOption {
+	return func(s *subprocess) {
+		s.ui = ui
+	}
+}
+
+// Command and arguments used to run the command line with the
+// host shell
+func shellCommand(line string) []string {
+	if runtime.GOOS == "windows" {
+		return []string{"cmd.exe", "/C", line}
+	}
+
+	return []string{"/bin/sh", "-c", line}
+}
+
+// Subprocess runs a command on the host. The command receives the
+// environment provided to plugins (see WithPluginEnv). Output is
+// sent to the UI as it is written and is also captured in the
+// result. The command is killed if the context is cancelled. If the
+// command was run, the result is returned with the exit code even
+// when the command failed.
+func (b *Basis) Subprocess(
+	ctx context.Context, // context for the command
+	command []string, // command and arguments to run
+	opts ...SubprocessOption, // options for the command
+) (*SubprocessResult, error) {
+	s := &subprocess{
+		env:    map[string]string{},
+		logger: b.logger.Named("subprocess"),
+		ui:     b.ui,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if len(command) == 0 {
+		return nil, fmt.Errorf("no command provided")
+	}
+	if s.shell {
+		command = shellCommand(strings.Join(command, " "))
+	}
+
+	var stdout, stderr bytes.Buffer
+	outW, errW := io.Writer(&stdout), io.Writer(&stderr)
+	if s.ui != nil {
+		uiOut, uiErr, err := s.ui.OutputWriters()
+		if err != nil {
+			s.logger.Warn("error getting UI stdout/stderr", "error", err)
+			return nil, err
+		}
+		outW, errW = io.MultiWriter(uiOut, &stdout), io.MultiWriter(uiErr, &stderr)
+	}
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Dir = s.dir
+	cmd.Env = b.pluginEnv.Environ()
+	cmd.Stdout = outW
+	cmd.Stderr = errW
+
+	keys := make([]string, 0, len(s.env))
+	for k := range s.env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, s.env[k]))
+	}
+
+	s.logger.Debug("running host command",
+		"command", command,
+		"dir", s.dir,
+	)
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		s.logger.Warn("error starting command", "error", err)
+		return nil, err
+	}
+	err := cmd.Wait()
+
+	result := &SubprocessResult{
+		ExitCode: cmd.ProcessState.ExitCode(),
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+	}
+	if err != nil {
+		L := s.logger
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			L = L.With("code", exitErr.ExitCode())
+		}
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+
+		L.Warn("error running command", "error", err)
+		return result, err
+	}
+
+	s.logger.Debug("host command complete",
+		"command", command,
+		"duration", time.Since(start),
+	)
+
+	return result, nil
+}
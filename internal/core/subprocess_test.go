@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/config"
+)
+
+func TestBasisSubprocess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands require a POSIX shell")
+	}
+
+	t.Setenv("VAGRANT_TEST_HOST", "host")
+	rec := &testWriterUI{}
+	b := TestBasis(t,
+		WithUI(rec),
+		WithPluginEnv(map[string]string{"VAGRANT_TEST_VALUE": "basis"}),
+	)
+
+	result, err := b.Subprocess(context.Background(),
+		[]string{"echo", "$VAGRANT_TEST_HOST", "$VAGRANT_TEST_VALUE", "$VAGRANT_TEST_EXTRA"},
+		SubprocessShell(),
+		SubprocessEnv(map[string]string{"VAGRANT_TEST_EXTRA": "extra"}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, result.ExitCode)
+	require.Equal(t, "host basis extra\n", result.Stdout)
+	require.Contains(t, rec.stdout.String(), "host basis extra")
+
+	// Failed commands return the exit code and output
+	result, err = b.Subprocess(context.Background(),
+		[]string{"/bin/sh", "-c", "echo failed >&2; exit 3"},
+	)
+	require.Error(t, err)
+	require.Equal(t, 3, result.ExitCode)
+	require.Equal(t, "failed\n", result.Stderr)
+
+	// Commands are stopped when the context is cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = b.Subprocess(ctx, []string{"sleep", "10"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	_, err = b.Subprocess(context.Background(), nil)
+	require.Error(t, err)
+}
+
+func TestExecHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands require a POSIX shell")
+	}
+
+	rec := &testWriterUI{}
+	b := TestBasis(t,
+		WithUI(rec),
+		WithPluginEnv(map[string]string{"VAGRANT_TEST_VALUE": "hook"}),
+	)
+
+	require.NoError(t, b.execHook(context.Background(), b.logger, &config.Hook{
+		Command: []string{"/bin/sh", "-c", "echo $VAGRANT_TEST_VALUE"},
+	}))
+	require.Contains(t, rec.stdout.String(), "hook")
+	require.Error(t, b.execHook(context.Background(), b.logger, &config.Hook{
+		Command: []string{"false"},
+	}))
+}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 	"sync"
 	"time"
 
@@ -43,6 +42,7 @@ type Target struct {
 	dir         *datadir.Target             // data directory for target
 	factory     *Factory                    // scope factory
 	jobInfo     *component.JobInfo          // jobInfo is the base job info for executed functions
+	loaded      bool                        // flag that stored target data was provided
 	logger      hclog.Logger                // target specific logger
 	project     *Project                    // project which owns this target
 	ready       bool                        // flag that instance is ready
@@ -87,15 +87,21 @@ func (t *Target) Init() error {
 
 	// Configure our logger
 	t.logger = t.logger.ResetNamed("vagrant.core.target")
+	if t.project != nil && t.project.basis != nil {
+		t.logger = t.logger.With(t.project.basis.loggerFields()...)
+	}
 
 	// If no client is set, grab it from the project
 	if t.client == nil && t.project != nil {
 		t.client = t.project.client
 	}
 
-	// Attempt to reload the target to populate our
-	// data. If the target is not found, create it.
-	err = t.Reload()
+	// Attempt to reload the target to populate our data
+	// unless it was provided. If the target is not found,
+	// create it.
+	if !t.loaded {
+		err = t.Reload()
+	}
 	if err != nil {
 		stat, ok := status.FromError(err)
 		if !ok || stat.Code() != codes.NotFound {
@@ -125,9 +131,11 @@ func (t *Target) Init() error {
 		}
 	}
 
-	// If the ui is unset, use the project ui
-	if t.ui == nil {
-		t.ui = t.project.ui
+	// If the ui is unset, use the project ui. The target is
+	// given its own status so targets running in parallel
+	// are displayed separately.
+	if t.ui == nil && t.project.ui != nil {
+		t.ui = newTargetUI(t.project.ui, t.project.basis.uiStatus, t.target.Name)
 	}
 
 	// Save ourself when closed
@@ -275,25 +283,15 @@ func (t *Target) Communicate() (c core.Communicator, err error) {
 		c = i.(core.Communicator)
 		return
 	}
-	communicatorName := ""
-	rawCommunicatorName, err := t.vagrantfile.GetValue("vm", "communicator")
-	// If there is an error getting the communicator, default to using the ssh communicator
+	communicatorName, configured, err := t.communicatorName()
 	if err != nil {
-		communicatorName = DEFAULT_COMMUNICATOR_NAME
-	}
-	if rawCommunicatorName == nil {
-		communicatorName = DEFAULT_COMMUNICATOR_NAME
-	} else {
-		communicatorName, err = optionToString(rawCommunicatorName)
-		if err != nil {
-			return nil, err
-		}
+		return
 	}
+
 	communicator, err := t.project.basis.component(
 		t.ctx, component.CommunicatorType, communicatorName)
-
 	if err != nil {
-		return
+		return nil, t.communicatorError(communicatorName, configured, err)
 	}
 	c = communicator.Value.(core.Communicator)
 
@@ -411,7 +409,7 @@ func (t *Target) Reload() (err error) {
 	)
 
 	if err != nil {
-		return
+		return newServerError(err, "find target", t.target.ResourceId)
 	}
 
 	t.target = result.Target
@@ -444,7 +442,7 @@ func (t *Target) Save() (err error) {
 		t.logger.Trace("failed to save target",
 			"error", err)
 
-		return
+		return newServerError(err, "save target", t.target.ResourceId)
 	}
 	t.target = result.Target
 	return
@@ -461,6 +459,9 @@ func (t *Target) Destroy() (err error) {
 	_, err = t.Client().DeleteTarget(t.ctx, &vagrant_server.DeleteTargetRequest{
 		Target: t.Ref().(*vagrant_plugin_sdk.Ref_Target),
 	})
+	if t.project != nil && t.project.vagrantfile != nil {
+		t.project.vagrantfile.forgetTarget(t.target.Name, t.target.ResourceId)
+	}
 
 	// Remove all the files inside the datadir without wiping the datadir itself
 	files, err := filepath.Glob(filepath.Join(t.dir.DataDir().String(), "*"))
@@ -477,46 +478,45 @@ func (t *Target) Destroy() (err error) {
 	return
 }
 
-func (t *Target) Run(ctx context.Context, task *vagrant_server.Job_CommandOp) (err error) {
-	t.logger.Debug("running new command",
-		"command", task)
-
-	cmd, err := t.project.basis.component(
-		ctx, component.CommandType, task.Component.Name)
-
-	if err != nil {
-		t.logger.Error("failed to build requested component",
-			"type", component.CommandType,
-			"name", task.Component.Name,
-			"error", err)
-		return
-	}
-
-	fn := cmd.Value.(component.Command).ExecuteFunc(
-		strings.Split(task.Command, " "))
-	result, err := t.callDynamicFunc(ctx, t.logger, fn, (*int32)(nil),
-		argmapper.Typed(task.CliArgs, t.jobInfo, t.dir, t.ctx, t.ui),
-		argmapper.ConverterFunc(cmd.mappers...),
-	)
-
-	if err != nil || result == nil || result.(int32) != 0 {
-		t.logger.Error("failed to execute command",
-			"type", component.CommandType,
-			"name", task.Component.Name,
-			"error", err)
-
-		cmdErr := &runError{}
-		if err != nil {
-			cmdErr.err = err
-		}
-		if result != nil {
-			cmdErr.exitCode = result.(int32)
-		}
-
-		return cmdErr
-	}
-
-	return
+func (t *Target) Run(ctx context.Context, task *vagrant_server.Job_CommandOp) (warnings []string, err error) {
+	return t.run(ctx, task, t.ui)
+}
+
+// Run the task using the provided UI for output
+func (t *Target) run(
+	ctx context.Context, // context for the command
+	task *vagrant_server.Job_CommandOp, // task to run
+	ui terminal.UI, // UI for command output
+) ([]string, error) {
+	return t.project.basis.wrapRun(func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+		return t.runCommand(ctx, task, ui)
+	})(ctx, task)
+}
+
+// Run the task's command component using the provided UI for output
+func (t *Target) runCommand(
+	ctx context.Context, // context for the command
+	task *vagrant_server.Job_CommandOp, // task to run
+	ui terminal.UI, // UI for command output
+) ([]string, error) {
+	load, release := t.project.basis.commandLoader()
+	defer release()
+
+	return t.project.basis.runCommandIn(ctx, task, &commandRun{
+		scope:     t,
+		ui:        ui,
+		logger:    t.logger,
+		cmdLogger: t.commandLogger(),
+		jobInfo:   t.jobInfo,
+		project:   t.project,
+		target:    t.target.Name,
+		typed:     []interface{}{task.CliArgs, t.jobInfo, t.dir, t.ctx, ui},
+		call:      t.callDynamicFunc,
+		load:      load,
+		rerun: func(ctx context.Context, task *vagrant_server.Job_CommandOp) ([]string, error) {
+			return t.run(ctx, task, ui)
+		},
+	})
 }
 
 // Vagrantfile implements core.Target
@@ -580,7 +580,7 @@ func (t *Target) callDynamicFunc(
 	args ...argmapper.Arg, // list of argmapper arguments
 ) (interface{}, error) {
 	// ensure our UI status is closed after every call in case it is used
-	defer t.ui.Status().Close()
+	defer t.project.basis.uiStatus.acquire(t.ui)()
 
 	return t.project.callDynamicFunc(ctx, log, f, expectedType, args...)
 }
@@ -590,7 +590,7 @@ func (t *Target) execHook(
 	log hclog.Logger,
 	h *config.Hook,
 ) error {
-	return execHook(ctx, t, log, h)
+	return execHook(ctx, t.project.basis, t, log, h)
 }
 
 func (t *Target) doOperation(
@@ -598,7 +598,17 @@ func (t *Target) doOperation(
 	log hclog.Logger,
 	op operation,
 ) (interface{}, proto.Message, error) {
-	return doOperation(ctx, log, t, op)
+	defer t.project.basis.projects.pin(t.project)()
+
+	return t.project.basis.wrapOperation(t, op)(ctx, log)
+}
+
+func (t *Target) retryPolicy(op operation) *operationRetry {
+	return t.project.retryPolicy(op)
+}
+
+func (t *Target) lifecycle() *eventStream {
+	return t.project.lifecycle()
 }
 
 // Options type for target loading
@@ -652,6 +662,16 @@ func WithTargetRef(r *vagrant_plugin_sdk.Ref_Target) TargetOption {
 	}
 }
 
+// Configure target with stored target data. The target
+// is not reloaded from the server when initialized.
+func WithTargetData(st *vagrant_server.Target) TargetOption {
+	return func(t *Target) (err error) {
+		t.target = st
+		t.loaded = true
+		return
+	}
+}
+
 func WithProvider(provider string) TargetOption {
 	return func(t *Target) (err error) {
 		if t != nil && t.target != nil && provider != "" {
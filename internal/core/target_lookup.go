@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"sync"
+)
+
+// targetLookup indexes the names of targets which have been
+// found by name or resource id, so repeated lookups are resolved
+// without a request to the server. Targets must be removed from
+// the index when they are deleted.
+type targetLookup struct {
+	names map[string]string // target name keyed by name and resource id
+
+	m sync.RWMutex
+}
+
+func newTargetLookup() *targetLookup {
+	return &targetLookup{
+		names: map[string]string{},
+	}
+}
+
+// Get the name of the target with the given name or resource id
+func (l *targetLookup) get(nameOrId string) (string, bool) {
+	l.m.RLock()
+	defer l.m.RUnlock()
+
+	name, ok := l.names[nameOrId]
+	return name, ok
+}
+
+// Add the target to the index
+func (l *targetLookup) add(name, resourceId string) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.names[name] = name
+	if resourceId != "" {
+		l.names[resourceId] = name
+	}
+}
+
+// Remove the target from the index
+func (l *targetLookup) remove(name, resourceId string) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	delete(l.names, name)
+	delete(l.names, resourceId)
+}
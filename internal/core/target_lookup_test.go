@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetLookup(t *testing.T) {
+	tp := TestMinimalProject(t)
+	projectTargets(t, tp, 2)
+
+	// Targets are indexed by name and resource id once found
+	name, err := tp.vagrantfile.targetNameLookup("id-0")
+	require.NoError(t, err)
+	require.Equal(t, "target-0", name)
+	name, ok := tp.vagrantfile.targets.get("target-0")
+	require.True(t, ok)
+	require.Equal(t, "target-0", name)
+	_, ok = tp.vagrantfile.targets.get("target-1")
+	require.False(t, ok)
+
+	// Removed targets are no longer indexed
+	require.NoError(t, tp.RemoveTarget("target-0", WithRemoveForce()))
+	_, ok = tp.vagrantfile.targets.get("id-0")
+	require.False(t, ok)
+	_, err = tp.vagrantfile.targetNameLookup("target-0")
+	require.Error(t, err)
+}
+
+// benchmarkT allows test helpers to be used within benchmarks
+type benchmarkT struct {
+	*testing.B
+}
+
+func (benchmarkT) Parallel() {}
+
+func BenchmarkTargetLookup(b *testing.B) {
+	tp := TestMinimalProject(benchmarkT{b})
+	for i := 0; i < 200; i++ {
+		TestTarget(benchmarkT{b}, tp, &vagrant_server.Target{
+			ResourceId: fmt.Sprintf("id-%d", i),
+			Name:       fmt.Sprintf("target-%d", i),
+		})
+	}
+	v := tp.vagrantfile
+
+	b.Run("server", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			v.targets = newTargetLookup()
+			if _, err := v.targetNameLookup(fmt.Sprintf("id-%d", i%200)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("indexed", func(b *testing.B) {
+		for i := 0; i < 200; i++ {
+			v.targets.add(fmt.Sprintf("target-%d", i), fmt.Sprintf("id-%d", i))
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := v.targetNameLookup(fmt.Sprintf("id-%d", i%200)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
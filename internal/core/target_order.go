@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Dependencies of the targets within the project. Dependencies
+// are configured using the depends_on option of target blocks
+// within the basis configuration.
+func (p *Project) configuredDependencies() (map[string][]string, error) {
+	deps := map[string][]string{}
+	if p.basis == nil {
+		return deps, nil
+	}
+
+	cfg, err := p.basis.Config()
+	if err != nil || cfg == nil {
+		return deps, err
+	}
+	for _, t := range cfg.Targets {
+		deps[t.Name] = t.DependsOn
+	}
+
+	return deps, nil
+}
+
+// TargetOrder returns the order in which the named targets must
+// be run based on their dependencies. Targets are grouped into
+// stages. Targets within a stage do not depend on each other and
+// all of their dependencies are within earlier stages. If no
+// names are given all targets within the project are ordered.
+// Dependencies on targets which are not being ordered are ignored
+// and a warning is logged.
+func (p *Project) TargetOrder(names ...string) ([][]string, error) {
+	deps, err := p.targetDependencies(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderTargets(deps)
+}
+
+// Dependencies of each of the named targets. If no names are
+// given the dependencies of all targets within the project are
+// returned.
+func (p *Project) targetDependencies(names ...string) (map[string][]string, error) {
+	all, err := p.TargetNames()
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		names = all
+	}
+
+	// Targets declared in the Vagrantfile may not be stored yet
+	declared, err := p.orderedTargetNames()
+	if err != nil {
+		return nil, err
+	}
+	known := map[string]struct{}{}
+	for _, n := range declared {
+		known[n] = struct{}{}
+	}
+
+	configured, err := p.configuredDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	included := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		if _, err := p.LoadTarget(n); err != nil {
+			return nil, err
+		}
+		included[n] = struct{}{}
+	}
+
+	deps := make(map[string][]string, len(names))
+	for _, n := range names {
+		d := configured[n]
+		for _, dn := range d {
+			if _, ok := known[dn]; !ok {
+				return nil, fmt.Errorf("target %s depends on unknown target %s", n, dn)
+			}
+			if _, ok := included[dn]; !ok {
+				p.logger.Warn("target dependency is not included in run, ignoring",
+					"target", n,
+					"dependency", dn,
+				)
+			}
+		}
+		deps[n] = d
+	}
+
+	return deps, nil
+}
+
+// Order the targets into stages using their dependencies. Only
+// dependencies which are included in the map are considered.
+func orderTargets(deps map[string][]string) ([][]string, error) {
+	// Number of unresolved dependencies for each target and
+	// the targets which depend on each target
+	pending := make(map[string]int, len(deps))
+	dependents := map[string][]string{}
+	for n, d := range deps {
+		pending[n] += 0
+		seen := map[string]struct{}{}
+		for _, dn := range d {
+			if _, ok := deps[dn]; !ok {
+				continue
+			}
+			if _, ok := seen[dn]; ok {
+				continue
+			}
+			seen[dn] = struct{}{}
+			pending[n]++
+			dependents[dn] = append(dependents[dn], n)
+		}
+	}
+
+	stages := [][]string{}
+	for len(pending) > 0 {
+		stage := []string{}
+		for n, c := range pending {
+			if c == 0 {
+				stage = append(stage, n)
+			}
+		}
+		if len(stage) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrTargetDependencyCycle,
+				strings.Join(dependencyCycle(deps, pending), " -> "))
+		}
+		sort.Strings(stage)
+
+		for _, n := range stage {
+			delete(pending, n)
+			for _, dn := range dependents[n] {
+				pending[dn]--
+			}
+		}
+		stages = append(stages, stage)
+	}
+
+	return stages, nil
+}
+
+// Find a dependency cycle within the unresolved targets
+func dependencyCycle(deps map[string][]string, pending map[string]int) []string {
+	names := make([]string, 0, len(pending))
+	for n := range pending {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	// Every unresolved target has an unresolved dependency so
+	// following them from any target must lead to a cycle
+	path := []string{}
+	visited := map[string]int{}
+	n := names[0]
+	for {
+		if i, ok := visited[n]; ok {
+			return append(path[i:], n)
+		}
+		visited[n] = len(path)
+		path = append(path, n)
+
+		d := append([]string{}, deps[n]...)
+		sort.Strings(d)
+		for _, dn := range d {
+			if _, ok := pending[dn]; ok {
+				n = dn
+				break
+			}
+		}
+	}
+}
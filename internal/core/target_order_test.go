@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/vagrant/internal/config"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderTargets(t *testing.T) {
+	stages, err := orderTargets(map[string][]string{
+		"app":   {"db", "cache"},
+		"db":    {},
+		"cache": {},
+		"proxy": {"app", "external"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"cache", "db"},
+		{"app"},
+		{"proxy"},
+	}, stages)
+}
+
+func TestOrderTargetsCycle(t *testing.T) {
+	_, err := orderTargets(map[string][]string{
+		"app":   {"db"},
+		"db":    {"queue"},
+		"queue": {"app"},
+		"web":   {},
+	})
+	require.ErrorIs(t, err, ErrTargetDependencyCycle)
+	require.Contains(t, err.Error(), "app -> db -> queue -> app")
+
+	_, err = orderTargets(map[string][]string{"app": {"app"}})
+	require.ErrorIs(t, err, ErrTargetDependencyCycle)
+}
+
+func TestProjectTargetOrder(t *testing.T) {
+	tp := TestMinimalProject(t)
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-one", Name: "one"})
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-two", Name: "two"})
+
+	stages, err := tp.TargetOrder()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"one", "two"}}, stages)
+
+	stages, err = tp.TargetOrder("two")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"two"}}, stages)
+}
+
+func TestProjectTargetOrderConfigured(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vagrant-config.hcl")
+	require.NoError(t, os.WriteFile(path, []byte(`
+target "two" {
+  depends_on = ["one"]
+}
+
+target "three" {
+  depends_on = ["one", "two"]
+}
+`), 0644))
+	cfg, err := config.Load(path, filepath.Dir(path))
+	require.NoError(t, err)
+
+	tp := TestProject(t, WithConfig(cfg))
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-one", Name: "one"})
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-two", Name: "two"})
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-three", Name: "three"})
+
+	stages, err := tp.TargetOrder()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"one"}, {"two"}, {"three"}}, stages)
+
+	// Dependencies which are not included are ignored
+	stages, err = tp.TargetOrder("one", "three")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"one"}, {"three"}}, stages)
+
+	stages, err = tp.TargetOrder("three")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"three"}}, stages)
+}
+
+func TestProjectTargetOrderConfiguredErrors(t *testing.T) {
+	tp := TestProject(t, WithConfig(&config.Config{
+		Targets: []*config.Target{
+			{Name: "one", DependsOn: []string{"missing"}},
+		},
+	}))
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-one", Name: "one"})
+
+	_, err := tp.TargetOrder()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "target one depends on unknown target missing")
+
+	tp = TestProject(t, WithConfig(&config.Config{
+		Targets: []*config.Target{
+			{Name: "one", DependsOn: []string{"two"}},
+			{Name: "two", DependsOn: []string{"one"}},
+		},
+	}))
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-cycle-one", Name: "one"})
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-cycle-two", Name: "two"})
+
+	_, err = tp.TargetOrder()
+	require.ErrorIs(t, err, ErrTargetDependencyCycle)
+}
+
+func TestProjectRunTargets(t *testing.T) {
+	tp := TestMinimalProject(t)
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-one", Name: "one"})
+	TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-two", Name: "two"})
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "unknown",
+		Component: &vagrant_server.Component{Name: "unknown"},
+	}
+
+	_, err := tp.RunTargets(context.Background(), nil, task, RunTargetsConcurrency(0))
+	require.Error(t, err)
+
+	// A failure does not stop other targets
+	results, err := tp.RunTargets(context.Background(), nil, task)
+	require.Error(t, err)
+	require.Len(t, results, 2)
+	for _, name := range []string{"one", "two"} {
+		require.Error(t, results[name].Err)
+		require.NotErrorIs(t, results[name].Err, context.Canceled)
+		require.Equal(t, int32(1), results[name].ExitCode)
+		require.Contains(t, err.Error(), "target "+name)
+	}
+
+	// Remaining targets are cancelled on failure with fail fast
+	results, err = tp.RunTargets(context.Background(), nil, task,
+		RunTargetsConcurrency(1), RunTargetsFailFast())
+	require.Error(t, err)
+	cancelled := 0
+	for _, r := range results {
+		if errors.Is(r.Err, context.Canceled) {
+			cancelled++
+		}
+	}
+	require.Equal(t, 1, cancelled)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+)
+
+// Provision runs the named provisioner plugin on the target.
+// Progress events are reported for the provisioning and
+// provisioners may report their own progress by requesting
+// Progress.
+func (t *Target) Provision(
+	ctx context.Context, // context for the provisioning
+	name string, // name of the provisioner plugin
+	args ...argmapper.Arg, // arguments for the provisioner
+) error {
+	b := t.project.basis
+	c, err := b.component(ctx, component.ProvisionerType, name)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	p, ok := c.Value.(component.Provisioner)
+	if !ok {
+		return fmt.Errorf("plugin %s is not a provisioner", name)
+	}
+
+	args = append(args,
+		argmapper.Typed(t.Machine()),
+		argmapper.ConverterFunc(c.mappers...),
+	)
+	tracker, err := b.callWithProgress(ctx, t.callDynamicFunc, t.ui,
+		fmt.Sprintf("provision %s %s", name, t.target.Name),
+		ProgressPhaseProvisioning, p.ProvisionFunc(), args...)
+	if err != nil {
+		return err
+	}
+	tracker.phase(ProgressPhaseCompleted, 100)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// testProvisioner records the machine it provisions and reports progress
+type testProvisioner struct {
+	plugin.TestPluginWithFakeBroker
+
+	err     error
+	machine core.Machine
+}
+
+func (p *testProvisioner) ConfigureFunc() interface{} {
+	return func() error { return nil }
+}
+
+func (p *testProvisioner) ProvisionFunc() interface{} {
+	return func(m core.Machine, progress *Progress) error {
+		p.machine = m
+		progress.Update("running script", 100)
+		return p.err
+	}
+}
+
+func (p *testProvisioner) CleanupFunc() interface{} {
+	return func() error { return nil }
+}
+
+func TestTargetProvision(t *testing.T) {
+	prov := &testProvisioner{}
+	p := plugin.TestPlugin(t, prov,
+		plugin.WithPluginName("shell"),
+		plugin.WithPluginTypes(component.ProvisionerType),
+	)
+	reporter := &testProgressEvents{}
+	tp := TestProject(t,
+		WithPluginManager(plugin.TestManager(t, p)),
+		WithProgressReporter(reporter),
+	)
+	tt := TestTarget(t, tp, &vagrant_server.Target{ResourceId: "id-web", Name: "web"})
+
+	require.NoError(t, tt.Provision(context.Background(), "shell"))
+	require.Equal(t, tt.Machine(), prov.machine)
+	require.Equal(t, []string{
+		ProgressPhaseStarted,
+		ProgressPhaseProvisioning,
+		"running script",
+		ProgressPhaseCompleted,
+	}, reporter.phases(t))
+	require.Equal(t, "provision shell web", reporter.events[0].Operation)
+
+	t.Run("failed provisioning", func(t *testing.T) {
+		reporter.events = nil
+		prov.err = errors.New("script failed")
+
+		err := tt.Provision(context.Background(), "shell")
+		require.ErrorIs(t, err, prov.err)
+		require.Equal(t, ProgressPhaseFailed,
+			reporter.events[len(reporter.events)-1].Phase)
+	})
+}
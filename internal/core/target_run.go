@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// Default number of targets a command is run on at once
+const defaultTargetConcurrency = 4
+
+// TargetResult is the result of running a command on a target
+type TargetResult struct {
+	Warnings []string // warnings generated by the command
+	ExitCode int32    // exit code of the command
+	Err      error    // error if the command failed or was not run
+}
+
+// RunTargetsOption is used to set options for RunTargets
+type RunTargetsOption func(*runTargetsOptions)
+
+type runTargetsOptions struct {
+	concurrency int  // maximum number of targets run at once
+	failFast    bool // stop running targets after a failure
+}
+
+// RunTargetsConcurrency sets the maximum number of targets the
+// command is run on at once
+func RunTargetsConcurrency(n int) RunTargetsOption {
+	return func(o *runTargetsOptions) {
+		o.concurrency = n
+	}
+}
+
+// RunTargetsFailFast cancels the command on all targets once
+// the command fails on any target
+func RunTargetsFailFast() RunTargetsOption {
+	return func(o *runTargetsOptions) {
+		o.failFast = true
+	}
+}
+
+// RunTargets runs the task on each of the named targets in
+// dependency order. Targets within a stage are run in parallel
+// up to the concurrency limit. A failure on one target does not
+// stop the others unless fail fast is enabled, but targets which
+// depend on a failed target are not run. When run on multiple
+// targets, output is prefixed with the target name. The result
+// of every target is returned, and the error includes each
+// target which failed.
+func (p *Project) RunTargets(
+	ctx context.Context, // context for the commands
+	names []string, // targets to run the task on
+	task *vagrant_server.Job_CommandOp, // task to run
+	opts ...RunTargetsOption, // options for the run
+) (results map[string]*TargetResult, err error) {
+	o := &runTargetsOptions{concurrency: defaultTargetConcurrency}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.concurrency < 1 {
+		return nil, fmt.Errorf("target concurrency must be greater than zero")
+	}
+
+	deps, err := p.targetDependencies(names...)
+	if err != nil {
+		return nil, err
+	}
+	stages, err := orderTargets(deps)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results = make(map[string]*TargetResult, len(deps))
+	slots := make(chan struct{}, o.concurrency)
+	var m sync.Mutex
+	record := func(name string, r *TargetResult) {
+		m.Lock()
+		defer m.Unlock()
+
+		results[name] = r
+		if r.Err != nil && o.failFast {
+			cancel()
+		}
+	}
+
+	for _, stage := range stages {
+		p.logger.Debug("running command on targets",
+			"command", task.Command,
+			"targets", stage,
+		)
+
+		var wg sync.WaitGroup
+		for _, name := range stage {
+			m.Lock()
+			dep := failedDependency(deps[name], results)
+			m.Unlock()
+			if dep != "" {
+				record(name, &TargetResult{
+					ExitCode: 1,
+					Err:      fmt.Errorf("%w: %s", ErrTargetDependencyFailed, dep),
+				})
+				continue
+			}
+			if ctx.Err() != nil {
+				record(name, &TargetResult{ExitCode: 1, Err: ctx.Err()})
+				continue
+			}
+
+			t, terr := p.LoadTarget(name)
+			if terr != nil {
+				record(name, &TargetResult{ExitCode: 1, Err: terr})
+				continue
+			}
+
+			ui := t.ui
+			if len(deps) > 1 {
+				ui = newFilterUI(ui, targetOutputPrefix(name))
+			}
+
+			wg.Add(1)
+			go func(name string, t *Target, ui terminal.UI) {
+				defer wg.Done()
+
+				select {
+				case slots <- struct{}{}:
+					defer func() { <-slots }()
+				case <-ctx.Done():
+				}
+				if ctx.Err() != nil {
+					record(name, &TargetResult{ExitCode: 1, Err: ctx.Err()})
+					return
+				}
+
+				w, terr := t.run(ctx, task, ui)
+				record(name, &TargetResult{
+					Warnings: w,
+					ExitCode: commandExitCode(terr),
+					Err:      terr,
+				})
+			}(name, t, ui)
+		}
+		wg.Wait()
+	}
+
+	failed := []string{}
+	for name, r := range results {
+		if r.Err != nil {
+			failed = append(failed, name)
+		}
+	}
+	sort.Strings(failed)
+	for _, name := range failed {
+		err = multierror.Append(err, fmt.Errorf("target %s: %w", name, results[name].Err))
+	}
+
+	return results, err
+}
+
+// Returns the first of the dependencies which did not
+// run successfully, if any
+func failedDependency(deps []string, results map[string]*TargetResult) string {
+	for _, d := range deps {
+		if r, ok := results[d]; ok && r.Err != nil {
+			return d
+		}
+	}
+
+	return ""
+}
+
+// Output filter which prefixes each line with the target name
+func targetOutputPrefix(name string) OutputFilter {
+	return func(line string) string {
+		return name + ": " + line
+	}
+}
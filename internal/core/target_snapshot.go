@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-argmapper"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+)
+
+const (
+	// Target metadata key used for storing snapshot names
+	snapshotMetadataKey = "vagrant/snapshots"
+
+	snapshotSaveCapability    = "snapshot_save"
+	snapshotRestoreCapability = "snapshot_restore"
+	snapshotListCapability    = "snapshot_list"
+	snapshotDeleteCapability  = "snapshot_delete"
+)
+
+var snapshotNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// SnapshotSave saves a snapshot of the target with the given name.
+// If a snapshot with the same name already exists an error is
+// returned unless force is set. When forced, the existing snapshot
+// is deleted from the provider before the new snapshot is saved.
+func (t *Target) SnapshotSave(ctx context.Context, name string, force bool) error {
+	if err := validateSnapshotName(name); err != nil {
+		return err
+	}
+
+	snapshots, err := t.snapshotNames()
+	if err != nil {
+		return err
+	}
+	if !force && snapshotIndex(snapshots, name) >= 0 {
+		return fmt.Errorf("snapshot %q already exists for target %s", name, t.target.Name)
+	}
+
+	if force {
+		existing, err := t.providerSnapshots(ctx)
+		if err != nil {
+			return err
+		}
+		if snapshotIndex(existing, name) >= 0 {
+			t.logger.Debug("deleting existing snapshot before saving",
+				"snapshot", name,
+			)
+			if _, err = t.snapshotCapability(ctx, snapshotDeleteCapability, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err = t.snapshotCapability(ctx, snapshotSaveCapability, name); err != nil {
+		return err
+	}
+
+	if snapshotIndex(snapshots, name) < 0 {
+		snapshots = append(snapshots, name)
+	}
+
+	return t.storeSnapshotNames(snapshots)
+}
+
+// SnapshotRestore restores the target to the snapshot with the given name
+func (t *Target) SnapshotRestore(ctx context.Context, name string) error {
+	if err := validateSnapshotName(name); err != nil {
+		return err
+	}
+
+	_, err := t.snapshotCapability(ctx, snapshotRestoreCapability, name)
+	return err
+}
+
+// SnapshotList returns the names of the snapshots available for the
+// target as reported by the provider. The stored snapshot metadata
+// is updated to match the provider.
+func (t *Target) SnapshotList(ctx context.Context) ([]string, error) {
+	snapshots, err := t.providerSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = t.storeSnapshotNames(snapshots); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// SnapshotDelete deletes the snapshot with the given name. An
+// error is returned if the provider does not have the snapshot.
+func (t *Target) SnapshotDelete(ctx context.Context, name string) error {
+	if err := validateSnapshotName(name); err != nil {
+		return err
+	}
+
+	existing, err := t.providerSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	if snapshotIndex(existing, name) < 0 {
+		return fmt.Errorf("snapshot %q does not exist for target %s", name, t.target.Name)
+	}
+
+	if _, err = t.snapshotCapability(ctx, snapshotDeleteCapability, name); err != nil {
+		return err
+	}
+
+	snapshots, err := t.snapshotNames()
+	if err != nil {
+		return err
+	}
+	if idx := snapshotIndex(snapshots, name); idx >= 0 {
+		snapshots = append(snapshots[:idx], snapshots[idx+1:]...)
+	}
+
+	return t.storeSnapshotNames(snapshots)
+}
+
+// Snapshot names reported by the provider
+func (t *Target) providerSnapshots(ctx context.Context) ([]string, error) {
+	raw, err := t.snapshotCapability(ctx, snapshotListCapability)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := raw.(type) {
+	case nil:
+		return []string{}, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		snapshots := make([]string, len(v))
+		for i, n := range v {
+			if snapshots[i], err = optionToString(n); err != nil {
+				return nil, err
+			}
+		}
+		return snapshots, nil
+	default:
+		return nil, fmt.Errorf("unexpected snapshot list type from provider (%T)", raw)
+	}
+}
+
+// Run the requested snapshot capability on the target's provider
+func (t *Target) snapshotCapability(
+	ctx context.Context, // context for the request
+	name string, // name of the capability
+	args ...interface{}, // arguments for the capability
+) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	providerName, err := t.ProviderName()
+	if err != nil {
+		return nil, err
+	}
+	c, err := t.project.basis.component(ctx, component.ProviderType, providerName)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	unsupported := fmt.Errorf("snapshots are not supported by provider %s", providerName)
+	p, ok := c.Value.(component.CapabilityPlatform)
+	if !ok {
+		return nil, unsupported
+	}
+
+	raw, err := t.callDynamicFunc(ctx, t.logger, p.HasCapabilityFunc(), (*bool)(nil),
+		argmapper.Typed(&component.NamedCapability{Capability: name}),
+		argmapper.Typed(ctx),
+		argmapper.ConverterFunc(c.mappers...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !raw.(bool) {
+		return nil, unsupported
+	}
+
+	t.logger.Debug("running snapshot capability on provider",
+		"provider", providerName,
+		"capability", name,
+	)
+
+	args = append([]interface{}{t.Machine()}, args...)
+	return t.callDynamicFunc(ctx, t.logger, p.CapabilityFunc(name), false,
+		argmapper.Typed(&component.Direct{Arguments: args}),
+		argmapper.Typed(args...),
+		argmapper.Typed(ctx),
+		argmapper.ConverterFunc(c.mappers...),
+	)
+}
+
+// Snapshot names stored in the target metadata
+func (t *Target) snapshotNames() ([]string, error) {
+	snapshots := []string{}
+	if t.target.Metadata == nil {
+		return snapshots, nil
+	}
+
+	raw, ok := t.target.Metadata.Metadata[snapshotMetadataKey]
+	if !ok || raw == "" {
+		return snapshots, nil
+	}
+
+	if err := json.Unmarshal([]byte(raw), &snapshots); err != nil {
+		return nil, fmt.Errorf("failed to decode stored snapshot metadata: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// Persist the snapshot names in the target metadata
+func (t *Target) storeSnapshotNames(snapshots []string) error {
+	raw, err := json.Marshal(snapshots)
+	if err != nil {
+		return err
+	}
+
+	if t.target.Metadata == nil {
+		t.target.Metadata = &vagrant_plugin_sdk.Args_MetadataSet{}
+	}
+	if t.target.Metadata.Metadata == nil {
+		t.target.Metadata.Metadata = map[string]string{}
+	}
+	t.target.Metadata.Metadata[snapshotMetadataKey] = string(raw)
+
+	return t.Save()
+}
+
+func validateSnapshotName(name string) error {
+	if !snapshotNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid snapshot name %q, names must start with a letter or "+
+			"number and contain only letters, numbers, '.', '_', or '-'", name)
+	}
+
+	return nil
+}
+
+func snapshotIndex(snapshots []string, name string) int {
+	for i, s := range snapshots {
+		if s == name {
+			return i
+		}
+	}
+
+	return -1
+}
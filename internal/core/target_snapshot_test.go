@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+// testSnapshotProvider records the snapshot capabilities it runs
+type testSnapshotProvider struct {
+	plugin.TestPluginWithFakeBroker
+
+	calls       []string    // capabilities run with the snapshot name
+	list        interface{} // value returned by snapshot_list
+	unsupported bool        // report no capabilities
+}
+
+func (p *testSnapshotProvider) HasCapabilityFunc() interface{} {
+	return func(n *component.NamedCapability) bool {
+		return !p.unsupported
+	}
+}
+
+func (p *testSnapshotProvider) CapabilityFunc(name string) interface{} {
+	if name == snapshotListCapability {
+		return func(m core.Machine) (interface{}, error) {
+			return p.list, nil
+		}
+	}
+
+	return func(m core.Machine, snapshot string) error {
+		p.calls = append(p.calls, name+" "+snapshot)
+		return nil
+	}
+}
+
+func testSnapshotTarget(t *testing.T, impl interface{}) *Target {
+	tp := TestProject(t, WithPluginManager(plugin.TestManager(t,
+		plugin.TestPlugin(t, impl,
+			plugin.WithPluginName("snapper"),
+			plugin.WithPluginTypes(component.ProviderType),
+		),
+	)))
+
+	return TestTarget(t, tp, &vagrant_server.Target{
+		ResourceId: "id-web",
+		Name:       "web",
+		Provider:   "snapper",
+	})
+}
+
+func TestTargetSnapshotList(t *testing.T) {
+	p := &testSnapshotProvider{list: []interface{}{"before", "after"}}
+	tt := testSnapshotTarget(t, p)
+
+	names, err := tt.SnapshotList(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"before", "after"}, names)
+
+	stored, err := tt.snapshotNames()
+	require.NoError(t, err)
+	require.Equal(t, names, stored)
+
+	p.list = []interface{}{1}
+	_, err = tt.SnapshotList(context.Background())
+	require.Error(t, err)
+
+	p.list = map[string]string{}
+	_, err = tt.SnapshotList(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected snapshot list type")
+}
+
+func TestTargetSnapshotSaveForce(t *testing.T) {
+	p := &testSnapshotProvider{list: []string{"existing"}}
+	tt := testSnapshotTarget(t, p)
+	require.NoError(t, tt.storeSnapshotNames([]string{"existing"}))
+
+	require.NoError(t, tt.SnapshotSave(context.Background(), "existing", true))
+	require.Equal(t, []string{
+		snapshotDeleteCapability + " existing",
+		snapshotSaveCapability + " existing",
+	}, p.calls)
+
+	names, err := tt.snapshotNames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"existing"}, names)
+
+	t.Run("new snapshot is saved without delete", func(t *testing.T) {
+		p.calls = nil
+		require.NoError(t, tt.SnapshotSave(context.Background(), "fresh", true))
+		require.Equal(t, []string{snapshotSaveCapability + " fresh"}, p.calls)
+	})
+}
+
+func TestTargetSnapshotDeleteMissing(t *testing.T) {
+	p := &testSnapshotProvider{list: []string{"existing"}}
+	tt := testSnapshotTarget(t, p)
+
+	err := tt.SnapshotDelete(context.Background(), "missing")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not exist")
+	require.Empty(t, p.calls)
+
+	require.NoError(t, tt.SnapshotDelete(context.Background(), "existing"))
+	require.Equal(t, []string{snapshotDeleteCapability + " existing"}, p.calls)
+}
+
+func TestTargetSnapshotUnsupported(t *testing.T) {
+	for name, impl := range map[string]interface{}{
+		"no capabilities":      &testSnapshotProvider{unsupported: true},
+		"no capability plugin": &plugin.TestPluginWithFakeBroker{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt := testSnapshotTarget(t, impl)
+
+			err := tt.SnapshotSave(context.Background(), "snap", false)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "not supported by provider snapper")
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package core
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/vagrant-plugin-sdk/component"
@@ -112,3 +113,102 @@ func TestTargetConfigedCommunicator(t *testing.T) {
 		}
 	}
 }
+
+func TestTargetSnapshotSaveValidation(t *testing.T) {
+	tt := TestMinimalTarget(t)
+
+	for _, name := range []string{"", "-leading", "has space", "bad/name"} {
+		require.Error(t, tt.SnapshotSave(context.Background(), name, false), name)
+	}
+
+	require.NoError(t, tt.storeSnapshotNames([]string{"existing"}))
+	err := tt.SnapshotSave(context.Background(), "existing", false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already exists")
+
+	names, err := tt.snapshotNames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"existing"}, names)
+}
+
+func TestTargetCommunicatorSelection(t *testing.T) {
+	windowsConfig := &component.ConfigData{
+		Data: map[string]interface{}{
+			"vm": &component.ConfigData{
+				Data: map[string]interface{}{
+					"guest": "windows",
+				},
+			},
+		},
+	}
+
+	t.Run("windows guest uses winrm", func(t *testing.T) {
+		winrmMock := BuildTestCommunicatorPlugin("winrm")
+		pluginManager := plugin.TestManager(t,
+			plugin.TestPlugin(t,
+				winrmMock,
+				plugin.WithPluginName("winrm"),
+				plugin.WithPluginTypes(component.CommunicatorType),
+			),
+		)
+		tp := TestProject(t, WithPluginManager(pluginManager))
+		tm := TestMachine(t, tp, WithTestTargetConfig(windowsConfig))
+
+		name, configured, err := tm.communicatorName()
+		require.NoError(t, err)
+		require.False(t, configured)
+		require.Equal(t, WINRM_COMMUNICATOR_NAME, name)
+
+		comm, err := tm.Communicate()
+		require.NoError(t, err)
+		require.NotNil(t, comm)
+	})
+
+	t.Run("default communicator not installed", func(t *testing.T) {
+		tp := TestProject(t, WithPluginManager(plugin.TestManager(t)))
+		tm := TestMachine(t, tp, WithTestTargetConfig(windowsConfig))
+
+		_, err := tm.Communicate()
+		require.ErrorIs(t, err, ErrNoCommunicator)
+	})
+
+	t.Run("configured communicator not installed", func(t *testing.T) {
+		tp := TestProject(t, WithPluginManager(plugin.TestManager(t)))
+		tm := TestMachine(t, tp, WithTestTargetConfig(testCommunicatorConfig("winrm")))
+
+		_, err := tm.Communicate()
+		require.ErrorIs(t, err, ErrCommunicatorNotInstalled)
+		require.Contains(t, err.Error(), "winrm")
+	})
+}
+
+func TestTargetWinRMInfo(t *testing.T) {
+	tp := TestMinimalProject(t)
+	tm := TestMachine(t, tp,
+		WithTestTargetConfig(&component.ConfigData{
+			Data: map[string]interface{}{
+				"winrm": &component.ConfigData{
+					Data: map[string]interface{}{
+						"host":      "10.0.0.5",
+						"transport": "ssl",
+						"username":  "admin",
+					},
+				},
+			},
+		}),
+	)
+
+	info, err := tm.WinRMInfo()
+	require.NoError(t, err)
+	require.Equal(t, &WinRMInfo{
+		Host:      "10.0.0.5",
+		Port:      5986,
+		Transport: "ssl",
+		Username:  "admin",
+		Password:  "vagrant",
+	}, info)
+
+	tm = TestMachine(t, tp)
+	_, err = tm.WinRMInfo()
+	require.Error(t, err)
+}
@@ -134,11 +134,19 @@ func TestBasis(t testing.T, opts ...BasisOption) (b *Basis) {
 	defaultOpts := []BasisOption{
 		WithFactory(factory),
 		WithClient(client),
-		WithBasisDataDir(projDir),
 		WithBasisRef(&vagrant_plugin_sdk.Ref_Basis{Name: name, Path: td}),
 	}
 
-	b, err = factory.NewBasis("", append(defaultOpts, opts...)...)
+	// Use the test data directory unless the options provide
+	// the data directory or a basis path to derive it from
+	testDataDir := func(b *Basis) error {
+		if b.dir == nil && b.rootPath == "" {
+			b.dir = projDir
+		}
+		return nil
+	}
+
+	b, err = factory.NewBasis("", append(append(defaultOpts, opts...), testDataDir)...)
 	require.NoError(t, err)
 
 	require.NoError(t, b.Save())
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+)
+
+// valuesContext provides the values of another context, like
+// trace spans, without its cancellation or deadline. Values of
+// the wrapped context take precedence.
+type valuesContext struct {
+	context.Context
+
+	values context.Context
+}
+
+// Value implements context.Context
+func (c *valuesContext) Value(key interface{}) interface{} {
+	if v := c.Context.Value(key); v != nil {
+		return v
+	}
+
+	return c.values.Value(key)
+}
+
+// Include the values of the trace context in the context
+func (b *Basis) withTraceContext(ctx context.Context) context.Context {
+	if b.traceCtx == nil {
+		return ctx
+	}
+
+	return &valuesContext{Context: ctx, values: b.traceCtx}
+}
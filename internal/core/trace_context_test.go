@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+
+	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
+)
+
+type testTraceKey struct{}
+
+// testContextCommand records the context it is run with
+type testContextCommand struct {
+	ctx context.Context
+}
+
+func (c *testContextCommand) ExecuteFunc([]string) interface{} {
+	return func(ctx context.Context) int32 {
+		c.ctx = ctx
+		return 0
+	}
+}
+
+func (c *testContextCommand) CommandInfoFunc() interface{} {
+	return func() *component.CommandInfo {
+		return &component.CommandInfo{}
+	}
+}
+
+func TestBasisTraceContext(t *testing.T) {
+	caller, cancel := context.WithCancel(
+		context.WithValue(context.Background(), testTraceKey{}, "caller span"))
+	cancel()
+
+	b := TestBasis(t, WithTraceContext(caller))
+	require.Equal(t, "caller span", b.ctx.Value(testTraceKey{}))
+	require.NoError(t, b.ctx.Err())
+
+	// Plugin functions receive the caller values
+	cmd := &testContextCommand{}
+	load := func(context.Context, string) (*Component, error) {
+		return &Component{Value: cmd}, nil
+	}
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "up",
+		Component: &vagrant_server.Component{Name: "up"},
+	}
+	_, err := b.runCommandComponent(context.Background(), task, nil, load)
+	require.NoError(t, err)
+	require.Equal(t, "caller span", cmd.ctx.Value(testTraceKey{}))
+
+	_, err = NewBasis(context.Background(), WithTraceContext(nil))
+	require.Error(t, err)
+}
+
+// testSpanRecorder keeps the ended spans of a single trace in memory
+type testSpanRecorder struct {
+	spans   []*trace.SpanData
+	traceID trace.TraceID
+
+	m sync.Mutex
+}
+
+// ExportSpan implements trace.Exporter
+func (r *testSpanRecorder) ExportSpan(s *trace.SpanData) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if s.TraceID == r.traceID {
+		r.spans = append(r.spans, s)
+	}
+}
+
+// Ended span with the given name
+func (r *testSpanRecorder) span(t *testing.T, name string) *trace.SpanData {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for _, s := range r.spans {
+		if s.Name == name {
+			return s
+		}
+	}
+	require.Failf(t, "span not found", "no span named %q was ended", name)
+
+	return nil
+}
+
+// Start a sampled span which is recorded until the test completes
+func testTraceSpan(t *testing.T, name string) (context.Context, *trace.Span, *testSpanRecorder) {
+	ctx, span := trace.StartSpan(context.Background(), name,
+		trace.WithSampler(trace.AlwaysSample()))
+	r := &testSpanRecorder{traceID: span.SpanContext().TraceID}
+	trace.RegisterExporter(r)
+	t.Cleanup(func() { trace.UnregisterExporter(r) })
+
+	return ctx, span, r
+}
+
+// testSpanCommand starts a span from the context it is run with
+type testSpanCommand struct{}
+
+func (c *testSpanCommand) ExecuteFunc([]string) interface{} {
+	return func(ctx context.Context) int32 {
+		_, span := trace.StartSpan(ctx, "execute")
+		span.End()
+		return 0
+	}
+}
+
+func (c *testSpanCommand) CommandInfoFunc() interface{} {
+	return func() *component.CommandInfo {
+		return &component.CommandInfo{}
+	}
+}
+
+func TestBasisTraceContextSpans(t *testing.T) {
+	ctx, caller, spans := testTraceSpan(t, "caller")
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	b := TestBasis(t, WithTraceContext(ctx))
+	load := func(context.Context, string) (*Component, error) {
+		return &Component{Value: &testSpanCommand{}}, nil
+	}
+	task := &vagrant_server.Job_CommandOp{
+		Command:   "up",
+		Component: &vagrant_server.Component{Name: "up"},
+	}
+
+	// Spans started by the command are children of the caller span
+	_, err := b.runCommandComponent(context.Background(), task, nil, load)
+	require.NoError(t, err)
+	caller.End()
+
+	parent := spans.span(t, "caller")
+	child := spans.span(t, "execute")
+	require.Equal(t, parent.TraceID, child.TraceID)
+	require.Equal(t, parent.SpanID, child.ParentSpanID)
+	require.NotEqual(t, parent.SpanID, child.SpanID)
+
+	// Spans started by an operation using a new context from
+	// the basis continue the trace
+	_, span := trace.StartSpan(b.withTraceContext(context.Background()), "operation")
+	span.End()
+	require.Equal(t, parent.SpanID, spans.span(t, "operation").ParentSpanID)
+}
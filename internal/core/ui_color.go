@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/fatih/color"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/mattn/go-isatty"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+// ColorMode controls if color is used for UI output
+type ColorMode uint
+
+const (
+	ColorAuto   ColorMode = iota // use color when output is a terminal
+	ColorAlways                  // always use color
+	ColorNever                   // never use color
+)
+
+// Request metadata key used to provide the color setting to plugins
+const uiColorMetadataKey = "ui_color"
+
+func (m ColorMode) String() string {
+	switch m {
+	case ColorAlways:
+		return "always"
+	case ColorNever:
+		return "never"
+	default:
+		return "auto"
+	}
+}
+
+// Determine if color should be enabled. When in auto mode
+// the NO_COLOR and FORCE_COLOR environment variables are
+// respected, otherwise color is only enabled when output
+// is a terminal.
+func (m ColorMode) enabled(tty bool) bool {
+	switch m {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if v := os.Getenv("FORCE_COLOR"); v != "" {
+		if force, err := strconv.ParseBool(v); err == nil {
+			return force
+		}
+		return true
+	}
+
+	return tty
+}
+
+// Determine if color is enabled even when output is not a
+// terminal, like when FORCE_COLOR is set or color is always used
+func (m ColorMode) forced() bool {
+	return m.enabled(false)
+}
+
+// Returns if color is enabled for the basis UI
+func (b *Basis) uiColor() bool {
+	fd := os.Stdout.Fd()
+	return b.colorMode.enabled(isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd))
+}
+
+// Provide the color setting to plugins so their output matches
+func (b *Basis) setPluginColor(i *plugin.Instance, l hclog.Logger) error {
+	s, ok := i.Component.(plugin.HasPluginMetadata)
+	if !ok {
+		l.Trace("plugin does not support metadata, cannot assign color setting",
+			"component", i.Type.String(),
+			"name", i.Name,
+		)
+
+		return nil
+	}
+
+	s.SetRequestMetadata(uiColorMetadataKey, strconv.FormatBool(b.uiColor()))
+
+	return nil
+}
+
+// Matches ANSI escape sequences
+var ansiPattern = regexp.MustCompile("[\u001B\u009B][[\\]()#;?]*(?:(?:(?:[a-zA-Z\\d]*(?:;[a-zA-Z\\d]*)*)?\u0007)|(?:(?:\\d{1,4}(?:;\\d{0,4})*)?[\\dA-PRZcf-ntqry=><~]))")
+
+// Remove ANSI escape sequences from the line
+func stripColor(line string) string {
+	return ansiPattern.ReplaceAllString(line, "")
+}
+
+// noColorUI wraps a UI and removes color from all output. This
+// is applied to the UI of a single basis so other UIs within the
+// process are not affected.
+type noColorUI struct {
+	*filterUI
+}
+
+// Wrap the UI so output does not include color
+func newNoColorUI(ui terminal.UI) *noColorUI {
+	return &noColorUI{filterUI: newFilterUI(ui, stripColor)}
+}
+
+// Output implements terminal.UI
+func (u *noColorUI) Output(msg string, raw ...interface{}) {
+	opts := []terminal.Option{}
+	for _, r := range raw {
+		if opt, ok := r.(terminal.Option); ok {
+			opts = append(opts, opt)
+		}
+	}
+
+	u.filterUI.Output(msg, append(raw, optionArgs(noColorOptions(opts))...)...)
+}
+
+// NamedValues implements terminal.UI
+func (u *noColorUI) NamedValues(rows []terminal.NamedValue, opts ...terminal.Option) {
+	u.filterUI.NamedValues(rows, append(opts, noColorOptions(opts)...)...)
+}
+
+// Table implements terminal.UI
+func (u *noColorUI) Table(tbl *terminal.Table, opts ...terminal.Option) {
+	plain := terminal.NewTable(tbl.Headers...)
+	for _, row := range tbl.Rows {
+		entries := make([]terminal.TableEntry, len(row))
+		for i, e := range row {
+			entries[i] = terminal.TableEntry{Value: e.Value}
+		}
+		plain.Rows = append(plain.Rows, entries)
+	}
+
+	u.filterUI.Table(plain, append(opts, noColorOptions(opts)...)...)
+}
+
+// Options which remove color from the output, including the
+// styling added by the wrapped UI, by writing the output through
+// a noColorWriter
+func noColorOptions(opts []terminal.Option) []terminal.Option {
+	_, _, _, w, _ := terminal.Interpret("", optionArgs(opts)...)
+
+	return []terminal.Option{
+		terminal.WithColor(""),
+		terminal.WithWriter(&noColorWriter{w: w}),
+	}
+}
+
+// Options as arguments for terminal.UI Output
+func optionArgs(opts []terminal.Option) []interface{} {
+	result := make([]interface{}, len(opts))
+	for i, o := range opts {
+		result[i] = o
+	}
+
+	return result
+}
+
+// noColorWriter removes color from everything written
+type noColorWriter struct {
+	w io.Writer
+}
+
+// Write implements io.Writer
+func (w *noColorWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.w, stripColor(string(p))); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Configure color output for the console UI. The console UI
+// disables color when output is not a terminal, so color is
+// enabled when it is forced for the basis. Since this applies to
+// all console UIs within the process, color is removed from the
+// output of a basis with color disabled instead.
+func (b *Basis) configureColor() {
+	if !b.uiColor() {
+		b.ui = newNoColorUI(b.ui)
+		return
+	}
+	if b.colorMode.forced() {
+		color.NoColor = false
+	}
+}
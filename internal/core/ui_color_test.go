@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/plugin"
+)
+
+type testMetadataComponent struct {
+	metadata map[string]string
+}
+
+func (c *testMetadataComponent) SetRequestMetadata(k, v string) {
+	c.metadata[k] = v
+}
+
+type testColorUI struct {
+	terminal.UI
+	lines  []string
+	colors []string
+}
+
+func (u *testColorUI) Output(msg string, raw ...interface{}) {
+	msg, _, _, _, color := terminal.Interpret(msg, raw...)
+	u.lines = append(u.lines, msg)
+	u.colors = append(u.colors, color)
+}
+
+func TestColorModeEnabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    ColorMode
+		tty     bool
+		noColor string
+		force   string
+		result  bool
+	}{
+		{name: "auto tty", mode: ColorAuto, tty: true, result: true},
+		{name: "auto non-tty", mode: ColorAuto, result: false},
+		{name: "auto no color", mode: ColorAuto, tty: true, noColor: "1", result: false},
+		{name: "auto force color", mode: ColorAuto, force: "1", result: true},
+		{name: "auto force disabled", mode: ColorAuto, tty: true, force: "0", result: false},
+		{name: "always", mode: ColorAlways, noColor: "1", result: true},
+		{name: "never", mode: ColorNever, tty: true, force: "1", result: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", tc.noColor)
+			t.Setenv("FORCE_COLOR", tc.force)
+			require.Equal(t, tc.result, tc.mode.enabled(tc.tty))
+		})
+	}
+}
+
+// Capture console UI output for the test. The color setting
+// of console UIs is restored when the test completes.
+func testConsoleOutput(t *testing.T) *bytes.Buffer {
+	out, noColor := color.Output, color.NoColor
+	t.Cleanup(func() {
+		color.Output, color.NoColor = out, noColor
+	})
+
+	buf := &bytes.Buffer{}
+	color.Output = buf
+	return buf
+}
+
+func TestBasisPluginColor(t *testing.T) {
+	testConsoleOutput(t)
+	b := TestBasis(t, WithUIColor(ColorAlways))
+	c := &testMetadataComponent{metadata: map[string]string{}}
+
+	require.NoError(t, b.setPluginColor(&plugin.Instance{Component: c}, hclog.NewNullLogger()))
+	require.Equal(t, "true", c.metadata[uiColorMetadataKey])
+
+	_, err := NewBasis(b.ctx, WithUIColor(ColorMode(10)))
+	require.Error(t, err)
+}
+
+func TestNoColorUI(t *testing.T) {
+	rec := &testColorUI{}
+	ui := newNoColorUI(rec)
+
+	ui.Output("\x1b[31mfailed\x1b[0m %s", "up", terminal.WithErrorStyle())
+	require.Equal(t, []string{"failed up"}, rec.lines)
+	require.Equal(t, []string{""}, rec.colors)
+}
+
+func TestBasisColor(t *testing.T) {
+	// Output is not a terminal so color is only used when forced
+	tests := []struct {
+		name  string
+		mode  ColorMode
+		force string
+		color bool
+	}{
+		{name: "auto", mode: ColorAuto},
+		{name: "auto force color", mode: ColorAuto, force: "1", color: true},
+		{name: "always", mode: ColorAlways, color: true},
+		{name: "never", mode: ColorNever},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", "")
+			t.Setenv("FORCE_COLOR", tc.force)
+			out := testConsoleOutput(t)
+			color.NoColor = true
+
+			b := TestBasis(t, WithUIColor(tc.mode))
+			b.ui.Output("booting", terminal.WithHeaderStyle())
+			require.Contains(t, out.String(), "booting")
+			require.Equal(t, tc.color, strings.Contains(out.String(), "\x1b["))
+		})
+	}
+
+	// A basis with color disabled removes color forced
+	// by another basis
+	out := testConsoleOutput(t)
+	TestBasis(t, WithUIColor(ColorAlways))
+	TestBasis(t, WithUIColor(ColorNever)).ui.Output("booting", terminal.WithHeaderStyle())
+	require.Contains(t, out.String(), "booting")
+	require.NotContains(t, out.String(), "\x1b[")
+
+	// Provided UIs are not modified
+	rec := &testColorUI{}
+	TestBasis(t, WithUIColor(ColorNever), WithUI(rec)).ui.Output("\x1b[1mbooting\x1b[0m")
+	require.Equal(t, []string{"\x1b[1mbooting\x1b[0m"}, rec.lines)
+}
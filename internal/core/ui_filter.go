@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// OutputFilter transforms a single line of output before it
+// is displayed. The line does not include the line ending.
+type OutputFilter func(string) string
+
+// filterUI wraps a UI and passes each line of output through
+// the filter before it is sent to the wrapped UI
+type filterUI struct {
+	terminal.UI
+
+	filter  OutputFilter
+	writers []*filterWriter // writers which may hold partial lines
+
+	m sync.Mutex
+}
+
+// Wrap the UI so all output is filtered
+func newFilterUI(ui terminal.UI, filter OutputFilter) *filterUI {
+	return &filterUI{UI: ui, filter: filter}
+}
+
+// Apply the filter to each line of the value
+func (u *filterUI) apply(v string) string {
+	lines := strings.Split(v, "\n")
+	for i, l := range lines {
+		lines[i] = u.filter(l)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (u *filterUI) unwrapUI() terminal.UI {
+	return u.UI
+}
+
+// Output implements terminal.UI
+func (u *filterUI) Output(msg string, raw ...interface{}) {
+	var args []interface{}
+	opts := []interface{}{}
+	for _, r := range raw {
+		if opt, ok := r.(terminal.Option); ok {
+			opts = append(opts, opt)
+		} else {
+			args = append(args, r)
+		}
+	}
+
+	u.UI.Output("%s", append([]interface{}{u.apply(fmt.Sprintf(msg, args...))}, opts...)...)
+}
+
+// NamedValues implements terminal.UI
+func (u *filterUI) NamedValues(rows []terminal.NamedValue, opts ...terminal.Option) {
+	filtered := make([]terminal.NamedValue, len(rows))
+	for i, row := range rows {
+		filtered[i] = terminal.NamedValue{
+			Name:  row.Name,
+			Value: u.apply(fmt.Sprintf("%v", row.Value)),
+		}
+	}
+
+	u.UI.NamedValues(filtered, opts...)
+}
+
+// Table implements terminal.UI
+func (u *filterUI) Table(tbl *terminal.Table, opts ...terminal.Option) {
+	filtered := terminal.NewTable(tbl.Headers...)
+	for _, row := range tbl.Rows {
+		entries := make([]terminal.TableEntry, len(row))
+		for i, e := range row {
+			entries[i] = terminal.TableEntry{Value: u.apply(e.Value), Color: e.Color}
+		}
+		filtered.Rows = append(filtered.Rows, entries)
+	}
+
+	u.UI.Table(filtered, opts...)
+}
+
+// OutputWriters implements terminal.UI
+func (u *filterUI) OutputWriters() (stdout, stderr io.Writer, err error) {
+	if stdout, stderr, err = u.UI.OutputWriters(); err != nil {
+		return
+	}
+
+	return u.writer(stdout), u.writer(stderr), nil
+}
+
+// Status implements terminal.UI
+func (u *filterUI) Status() terminal.Status {
+	return &filterStatus{Status: u.UI.Status(), ui: u}
+}
+
+// StepGroup implements terminal.UI
+func (u *filterUI) StepGroup() terminal.StepGroup {
+	return &filterStepGroup{StepGroup: u.UI.StepGroup(), ui: u}
+}
+
+// ReportError outputs the error details with the message
+// filtered if the wrapped UI supports structured errors
+func (u *filterUI) ReportError(d *ErrorDetails) {
+	if r, ok := u.UI.(errorReporter); ok {
+		filtered := *d
+		filtered.Message = u.apply(d.Message)
+		r.ReportError(&filtered)
+	}
+}
+
+// Create a filtering writer which is flushed with the UI
+func (u *filterUI) writer(w io.Writer) io.Writer {
+	u.m.Lock()
+	defer u.m.Unlock()
+
+	f := &filterWriter{w: w, filter: u.filter}
+	u.writers = append(u.writers, f)
+
+	return f
+}
+
+// Write any partial lines held by writers. Writers which
+// have been flushed are released.
+func (u *filterUI) flushOutput() {
+	u.m.Lock()
+	writers := u.writers
+	u.writers = nil
+	u.m.Unlock()
+
+	for _, w := range writers {
+		w.flush()
+	}
+}
+
+// outputFlusher is implemented by UIs which may hold
+// output that has not yet been displayed
+type outputFlusher interface {
+	flushOutput()
+}
+
+// Display any output held by the UI
+func flushOutput(ui terminal.UI) {
+	if f, ok := ui.(outputFlusher); ok {
+		f.flushOutput()
+	}
+}
+
+// filterWriter filters complete lines written to it. Partial
+// lines are held until the line is completed or the writer
+// is flushed.
+type filterWriter struct {
+	w       io.Writer
+	filter  OutputFilter
+	partial []byte // incomplete line from previous writes
+
+	m sync.Mutex
+}
+
+// Write implements io.Writer
+func (w *filterWriter) Write(p []byte) (int, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	data := append(w.partial, p...)
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		w.partial = data
+		return len(p), nil
+	}
+	w.partial = append([]byte{}, data[end+1:]...)
+
+	var out bytes.Buffer
+	for _, line := range bytes.Split(data[:end], []byte("\n")) {
+		out.WriteString(w.filter(string(line)))
+		out.WriteByte('\n')
+	}
+	if _, err := w.w.Write(out.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Write the held partial line
+func (w *filterWriter) flush() {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if len(w.partial) > 0 {
+		w.w.Write([]byte(w.filter(string(w.partial))))
+		w.partial = nil
+	}
+}
+
+type filterStatus struct {
+	terminal.Status
+
+	ui *filterUI
+}
+
+// Update implements terminal.Status
+func (s *filterStatus) Update(msg string) {
+	s.Status.Update(s.ui.apply(msg))
+}
+
+// Step implements terminal.Status
+func (s *filterStatus) Step(status, msg string) {
+	s.Status.Step(status, s.ui.apply(msg))
+}
+
+type filterStepGroup struct {
+	terminal.StepGroup
+
+	ui *filterUI
+}
+
+// Add implements terminal.StepGroup
+func (g *filterStepGroup) Add(msg string, args ...interface{}) terminal.Step {
+	step := g.StepGroup.Add("%s", g.ui.apply(fmt.Sprintf(msg, args...)))
+	return &filterStep{Step: step, ui: g.ui}
+}
+
+type filterStep struct {
+	terminal.Step
+
+	ui *filterUI
+}
+
+// TermOutput implements terminal.Step
+func (s *filterStep) TermOutput() io.Writer {
+	return s.ui.writer(s.Step.TermOutput())
+}
+
+// Update implements terminal.Step
+func (s *filterStep) Update(msg string, args ...interface{}) {
+	s.Step.Update("%s", s.ui.apply(fmt.Sprintf(msg, args...)))
+}
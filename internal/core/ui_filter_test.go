@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testWriterUI struct {
+	testRecordUI
+
+	stdout bytes.Buffer
+}
+
+func (u *testWriterUI) OutputWriters() (stdout, stderr io.Writer, err error) {
+	return &u.stdout, &u.stdout, nil
+}
+
+func TestBasisOutputFilter(t *testing.T) {
+	redact := func(line string) string {
+		return strings.ReplaceAll(line, "/home/user", "~")
+	}
+	rec := &testWriterUI{}
+	b := TestBasis(t, WithUI(rec), WithOutputFilter(redact))
+
+	ui, err := b.UI()
+	require.NoError(t, err)
+	ui.Output("saved to %s", "/home/user/box")
+	require.Equal(t, []string{"saved to ~/box"}, rec.lines)
+	require.NotContains(t, b.LastOperationOutput(), "/home/user")
+
+	// Partial lines are filtered once complete
+	stdout, _, err := ui.OutputWriters()
+	require.NoError(t, err)
+	fmt.Fprint(stdout, "one /home/")
+	require.Equal(t, "", rec.stdout.String())
+	fmt.Fprint(stdout, "user\ntwo /home/user")
+	require.Equal(t, "one ~\n", rec.stdout.String())
+
+	// Flushing writes the held partial line
+	flushOutput(ui)
+	require.Equal(t, "one ~\ntwo ~", rec.stdout.String())
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// machineReadableUI wraps a UI and formats all output using the
+// Vagrant machine readable format:
+//
+//	timestamp,target,type,data...
+//
+// Commas within data are replaced with `%!(VAGRANT_COMMA)` and
+// newlines are escaped so each entry is a single line.
+type machineReadableUI struct {
+	ui     terminal.UI // wrapped UI
+	target string      // target name included in output
+
+	m sync.Mutex
+}
+
+// NewMachineReadableUI returns a UI which wraps the provided UI
+// and outputs all content in the machine readable format.
+func NewMachineReadableUI(ui terminal.UI, target string) terminal.UI {
+	return &machineReadableUI{
+		ui:     ui,
+		target: target,
+	}
+}
+
+func (u *machineReadableUI) unwrapUI() terminal.UI {
+	return u.ui
+}
+
+// Input implements terminal.UI
+func (u *machineReadableUI) Input(*terminal.Input) (string, error) {
+	return "", terminal.ErrNonInteractive
+}
+
+// Interactive implements terminal.UI
+func (u *machineReadableUI) Interactive() bool {
+	return false
+}
+
+// MachineReadable implements terminal.UI
+func (u *machineReadableUI) MachineReadable() bool {
+	return true
+}
+
+// Output implements terminal.UI
+func (u *machineReadableUI) Output(msg string, raw ...interface{}) {
+	msg, _, _, _, _ = terminal.Interpret(msg, raw...)
+	u.emit("ui", "output", msg)
+}
+
+// ClearLine implements terminal.UI
+func (u *machineReadableUI) ClearLine() {}
+
+// NamedValues implements terminal.UI
+func (u *machineReadableUI) NamedValues(rows []terminal.NamedValue, _ ...terminal.Option) {
+	for _, row := range rows {
+		u.emit("named-value", row.Name, fmt.Sprintf("%v", row.Value))
+	}
+}
+
+// OutputWriters implements terminal.UI
+func (u *machineReadableUI) OutputWriters() (stdout, stderr io.Writer, err error) {
+	return &machineReadableWriter{ui: u, kind: "stdout"},
+		&machineReadableWriter{ui: u, kind: "stderr"}, nil
+}
+
+// Status implements terminal.UI
+func (u *machineReadableUI) Status() terminal.Status {
+	return &machineReadableStatus{ui: u}
+}
+
+// Table implements terminal.UI
+func (u *machineReadableUI) Table(tbl *terminal.Table, _ ...terminal.Option) {
+	if len(tbl.Headers) > 0 {
+		u.emit("table-header", tbl.Headers...)
+	}
+	for _, row := range tbl.Rows {
+		values := make([]string, len(row))
+		for i, e := range row {
+			values[i] = e.Value
+		}
+		u.emit("table-row", values...)
+	}
+}
+
+// StepGroup implements terminal.UI
+func (u *machineReadableUI) StepGroup() terminal.StepGroup {
+	return &machineReadableStepGroup{ui: u}
+}
+
+// ReportError outputs the error details as JSON
+func (u *machineReadableUI) ReportError(d *ErrorDetails) {
+	u.emit("error", d.Code, d.JSON())
+}
+
+// Write a single machine readable line to the wrapped UI
+func (u *machineReadableUI) emit(typ string, data ...string) {
+	u.m.Lock()
+	defer u.m.Unlock()
+
+	entries := []string{
+		strconv.FormatInt(time.Now().Unix(), 10),
+		machineReadableEscape(u.target),
+		machineReadableEscape(typ),
+	}
+	for _, d := range data {
+		entries = append(entries, machineReadableEscape(d))
+	}
+
+	u.ui.Output("%s", strings.Join(entries, ","))
+}
+
+// Escape value for inclusion in a machine readable line
+func machineReadableEscape(v string) string {
+	v = strings.ReplaceAll(v, ",", "%!(VAGRANT_COMMA)")
+	v = strings.ReplaceAll(v, "\n", "\\n")
+	v = strings.ReplaceAll(v, "\r", "\\r")
+	return v
+}
+
+type machineReadableStatus struct {
+	ui *machineReadableUI
+}
+
+// Update implements terminal.Status
+func (s *machineReadableStatus) Update(msg string) {
+	s.ui.emit("ui", "status", msg)
+}
+
+// Step implements terminal.Status
+func (s *machineReadableStatus) Step(status, msg string) {
+	s.ui.emit("ui", "status", status, msg)
+}
+
+// Close implements terminal.Status
+func (s *machineReadableStatus) Close() error {
+	return nil
+}
+
+type machineReadableStepGroup struct {
+	ui *machineReadableUI
+	wg sync.WaitGroup
+}
+
+// Add implements terminal.StepGroup
+func (g *machineReadableStepGroup) Add(msg string, args ...interface{}) terminal.Step {
+	g.wg.Add(1)
+	msg = fmt.Sprintf(msg, args...)
+	g.ui.emit("ui", "step", msg)
+
+	return &machineReadableStep{group: g, msg: msg}
+}
+
+// Wait implements terminal.StepGroup
+func (g *machineReadableStepGroup) Wait() {
+	g.wg.Wait()
+}
+
+type machineReadableStep struct {
+	group *machineReadableStepGroup
+	msg   string
+	done  sync.Once
+}
+
+// TermOutput implements terminal.Step
+func (s *machineReadableStep) TermOutput() io.Writer {
+	return &machineReadableWriter{ui: s.group.ui, kind: "step-output"}
+}
+
+// Update implements terminal.Step
+func (s *machineReadableStep) Update(msg string, args ...interface{}) {
+	s.msg = fmt.Sprintf(msg, args...)
+	s.group.ui.emit("ui", "step", s.msg)
+}
+
+// Status implements terminal.Step
+func (s *machineReadableStep) Status(status string) {
+	s.group.ui.emit("ui", "step-status", status, s.msg)
+}
+
+// Done implements terminal.Step
+func (s *machineReadableStep) Done() {
+	s.done.Do(s.group.wg.Done)
+}
+
+// Abort implements terminal.Step
+func (s *machineReadableStep) Abort() {
+	s.done.Do(func() {
+		s.group.ui.emit("ui", "step-status", terminal.StatusAbort, s.msg)
+		s.group.wg.Done()
+	})
+}
+
+// machineReadableWriter emits each written line as a
+// machine readable entry
+type machineReadableWriter struct {
+	ui   *machineReadableUI
+	kind string
+}
+
+func (w *machineReadableWriter) Write(p []byte) (int, error) {
+	for _, line := range bytes.Split(bytes.TrimRight(p, "\n"), []byte("\n")) {
+		w.ui.emit("ui", w.kind, string(line))
+	}
+
+	return len(p), nil
+}
+
+var _ terminal.UI = (*machineReadableUI)(nil)
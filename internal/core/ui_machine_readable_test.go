@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecordUI struct {
+	terminal.UI
+	lines []string
+}
+
+func (u *testRecordUI) Output(msg string, raw ...interface{}) {
+	u.lines = append(u.lines, fmt.Sprintf(msg, raw...))
+}
+
+func (u *testRecordUI) MachineReadable() bool {
+	return false
+}
+
+func TestMachineReadableUI(t *testing.T) {
+	rec := &testRecordUI{}
+	ui := NewMachineReadableUI(rec, "default")
+
+	ui.Output("hello, world\nagain")
+	ui.NamedValues([]terminal.NamedValue{{Name: "box", Value: "hashicorp/bionic64"}})
+	stdout, _, err := ui.OutputWriters()
+	require.NoError(t, err)
+	fmt.Fprintln(stdout, "from plugin")
+
+	require.Len(t, rec.lines, 3)
+	parts := strings.SplitN(rec.lines[0], ",", 2)
+	require.Equal(t, "default,ui,output,hello%!(VAGRANT_COMMA) world\\nagain", parts[1])
+	require.True(t, strings.HasSuffix(rec.lines[1], ",default,named-value,box,hashicorp/bionic64"))
+	require.True(t, strings.HasSuffix(rec.lines[2], ",default,ui,stdout,from plugin"))
+	require.True(t, ui.MachineReadable())
+	require.False(t, ui.Interactive())
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// UIMessageKind is the kind of message being rendered
+type UIMessageKind string
+
+const (
+	UIMessageInfo   UIMessageKind = "info"   // general output
+	UIMessageWarn   UIMessageKind = "warn"   // output using a warning style
+	UIMessageError  UIMessageKind = "error"  // output using an error style
+	UIMessageStep   UIMessageKind = "step"   // step added to or updated in a step group
+	UIMessageStatus UIMessageKind = "status" // live status update
+)
+
+// UIMessage is a message written to the UI
+type UIMessage struct {
+	Kind   UIMessageKind // kind of message
+	Text   string        // formatted message text
+	Status string        // status of the step or update, if any
+}
+
+// Renderer formats messages written to the UI. The rendered
+// message is displayed by the wrapped UI.
+type Renderer interface {
+	Render(msg *UIMessage) string
+}
+
+// RendererFunc allows a function to be used as a Renderer
+type RendererFunc func(msg *UIMessage) string
+
+// Render implements Renderer
+func (f RendererFunc) Render(msg *UIMessage) string {
+	return f(msg)
+}
+
+// PlainRenderer displays the message text unchanged
+func PlainRenderer() Renderer {
+	return RendererFunc(func(msg *UIMessage) string {
+		return msg.Text
+	})
+}
+
+// JSONLinesRenderer displays each message as a single line
+// JSON object including the kind of message and a timestamp
+func JSONLinesRenderer() Renderer {
+	return RendererFunc(func(msg *UIMessage) string {
+		data, err := json.Marshal(struct {
+			Time   time.Time     `json:"time"`
+			Kind   UIMessageKind `json:"kind"`
+			Text   string        `json:"text"`
+			Status string        `json:"status,omitempty"`
+		}{
+			Time:   time.Now().UTC(),
+			Kind:   msg.Kind,
+			Text:   msg.Text,
+			Status: msg.Status,
+		})
+		if err != nil {
+			return msg.Text
+		}
+
+		return string(data)
+	})
+}
+
+// renderUI wraps a UI and passes all messages, steps, and
+// status updates through the renderer before they are sent
+// to the wrapped UI
+type renderUI struct {
+	terminal.UI
+
+	renderer Renderer
+}
+
+// Wrap the UI so all messages are rendered. If the UI is
+// already rendered, the existing renderer is replaced.
+func newRenderUI(ui terminal.UI, r Renderer) *renderUI {
+	if c, ok := ui.(*captureUI); ok {
+		ui = c.UI
+	}
+	if u, ok := ui.(*renderUI); ok {
+		ui = u.UI
+	}
+
+	return &renderUI{UI: ui, renderer: r}
+}
+
+// Render the message
+func (u *renderUI) render(kind UIMessageKind, status, text string) string {
+	return u.renderer.Render(&UIMessage{
+		Kind:   kind,
+		Text:   text,
+		Status: status,
+	})
+}
+
+func (u *renderUI) unwrapUI() terminal.UI {
+	return u.UI
+}
+
+// Output implements terminal.UI
+func (u *renderUI) Output(msg string, raw ...interface{}) {
+	opts := []interface{}{}
+	for _, r := range raw {
+		if opt, ok := r.(terminal.Option); ok {
+			opts = append(opts, opt)
+		}
+	}
+
+	text, style, _, _, _ := terminal.Interpret(msg, raw...)
+	kind := UIMessageInfo
+	switch style {
+	case terminal.WarningStyle, terminal.WarningBoldStyle:
+		kind = UIMessageWarn
+	case terminal.ErrorStyle, terminal.ErrorBoldStyle:
+		kind = UIMessageError
+	}
+
+	u.UI.Output("%s", append([]interface{}{u.render(kind, "", text)}, opts...)...)
+}
+
+// Status implements terminal.UI
+func (u *renderUI) Status() terminal.Status {
+	return &renderStatus{Status: u.UI.Status(), ui: u}
+}
+
+// StepGroup implements terminal.UI
+func (u *renderUI) StepGroup() terminal.StepGroup {
+	return &renderStepGroup{StepGroup: u.UI.StepGroup(), ui: u}
+}
+
+// ReportError outputs the error details if the wrapped UI
+// supports structured errors
+func (u *renderUI) ReportError(d *ErrorDetails) {
+	if r, ok := u.UI.(errorReporter); ok {
+		r.ReportError(d)
+	}
+}
+
+type renderStatus struct {
+	terminal.Status
+
+	ui *renderUI
+}
+
+// Update implements terminal.Status
+func (s *renderStatus) Update(msg string) {
+	s.Status.Update(s.ui.render(UIMessageStatus, "", msg))
+}
+
+// Step implements terminal.Status
+func (s *renderStatus) Step(status, msg string) {
+	s.Status.Step(status, s.ui.render(UIMessageStatus, status, msg))
+}
+
+type renderStepGroup struct {
+	terminal.StepGroup
+
+	ui *renderUI
+}
+
+// Add implements terminal.StepGroup
+func (g *renderStepGroup) Add(msg string, args ...interface{}) terminal.Step {
+	text := fmt.Sprintf(msg, args...)
+	step := g.StepGroup.Add("%s", g.ui.render(UIMessageStep, "", text))
+	return &renderStep{Step: step, text: text, ui: g.ui}
+}
+
+type renderStep struct {
+	terminal.Step
+
+	text string
+	ui   *renderUI
+}
+
+// Update implements terminal.Step
+func (s *renderStep) Update(msg string, args ...interface{}) {
+	s.text = fmt.Sprintf(msg, args...)
+	s.Step.Update("%s", s.ui.render(UIMessageStep, "", s.text))
+}
+
+// Status implements terminal.Step
+func (s *renderStep) Status(status string) {
+	s.Step.Status(status)
+	s.Step.Update("%s", s.ui.render(UIMessageStep, status, s.text))
+}
+
+var _ terminal.UI = (*renderUI)(nil)
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+)
+
+// testStyledUI records output without the style options
+type testStyledUI struct {
+	testProgressUI
+}
+
+func (u *testStyledUI) Output(msg string, raw ...interface{}) {
+	msg, _, _, _, _ = terminal.Interpret(msg, raw...)
+	u.lines = append(u.lines, msg)
+}
+
+func TestBasisUIRenderer(t *testing.T) {
+	var rendered []*UIMessage
+	renderer := RendererFunc(func(msg *UIMessage) string {
+		rendered = append(rendered, msg)
+		return string(msg.Kind) + ": " + msg.Text
+	})
+	rec := &testStyledUI{}
+	b := TestBasis(t, WithUI(rec), WithUIRenderer(renderer))
+
+	ui, err := b.UI()
+	require.NoError(t, err)
+	ui.Output("hello %s", "world")
+	ui.Output("careful", terminal.WithWarningStyle())
+	ui.Output("failed", terminal.WithErrorStyle())
+	require.Equal(t, []string{
+		"info: hello world",
+		"warn: careful",
+		"error: failed",
+	}, rec.lines)
+	require.Contains(t, b.LastOperationOutput(), "careful")
+	require.NotContains(t, b.LastOperationOutput(), "warn: careful")
+
+	// Status updates are rendered
+	s := ui.Status()
+	s.Update("downloading")
+	s.Step(terminal.StatusOK, "downloaded")
+	require.Equal(t, []string{"status: downloading"}, rec.status.updates)
+	require.Equal(t, []string{"ok: status: downloaded"}, rec.status.steps)
+	require.Equal(t, terminal.StatusOK, rendered[len(rendered)-1].Status)
+
+	// Renderers cannot be combined with machine readable output
+	_, err = NewBasis(context.Background(), WithMachineReadableUI(), WithUIRenderer(PlainRenderer()))
+	require.ErrorIs(t, err, ErrConflictingOptions)
+	_, err = NewBasis(context.Background(), WithUIRenderer(nil))
+	require.Error(t, err)
+}
+
+func TestJSONLinesRenderer(t *testing.T) {
+	line := JSONLinesRenderer().Render(&UIMessage{Kind: UIMessageStep, Text: "booting", Status: "ok"})
+
+	var v map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(line), &v))
+	require.Equal(t, "step", v["kind"])
+	require.Equal(t, "booting", v["text"])
+	require.Equal(t, "ok", v["status"])
+	require.NotEmpty(t, v["time"])
+
+	require.Equal(t, "booting", PlainRenderer().Render(&UIMessage{Kind: UIMessageInfo, Text: "booting"}))
+}
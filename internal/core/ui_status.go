@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// wrappedUI is implemented by UIs which wrap another UI
+type wrappedUI interface {
+	unwrapUI() terminal.UI
+}
+
+// Returns the UI at the bottom of any wrapping UIs
+func baseUI(ui terminal.UI) terminal.UI {
+	for {
+		w, ok := ui.(wrappedUI)
+		if !ok {
+			return ui
+		}
+		ui = w.unwrapUI()
+	}
+}
+
+// uiStatusTracker tracks dynamic calls which are actively using
+// a UI. Since a UI status may be shared by all callers of the UI,
+// the status is only closed once every call using the UI has
+// completed. This prevents concurrent calls (like those run for
+// multiple targets in parallel) from closing the status of another
+// call while it is still in use. Calls are counted using the UI at
+// the bottom of any wrapping UIs, since wrappers share its status.
+//
+// Targets use a targetUI which provides each target with its own
+// status. The statuses are steps of a step group created from the
+// UI, so targets running in parallel are displayed separately. If
+// the UI does not provide step groups, targets share the status of
+// the UI.
+type uiStatusTracker struct {
+	active map[terminal.UI]*uiStatusEntry
+
+	m sync.Mutex
+}
+
+// Calls using a UI and the step group providing target statuses
+type uiStatusEntry struct {
+	calls   int                      // number of calls using the UI
+	group   terminal.StepGroup       // step group for target statuses
+	grouped bool                     // group was requested from the UI
+	targets map[string]*targetStatus // target statuses keyed by target name
+}
+
+func newUIStatusTracker() *uiStatusTracker {
+	return &uiStatusTracker{
+		active: map[terminal.UI]*uiStatusEntry{},
+	}
+}
+
+// Register a call as using the UI. The returned function must
+// be called when the call has completed.
+func (u *uiStatusTracker) acquire(ui terminal.UI) func() {
+	if ui == nil {
+		return func() {}
+	}
+
+	base := baseUI(ui)
+	target := ""
+	if tu, ok := ui.(*targetUI); ok {
+		target = tu.target
+	}
+
+	u.m.Lock()
+	e, ok := u.active[base]
+	if !ok {
+		e = &uiStatusEntry{targets: map[string]*targetStatus{}}
+		u.active[base] = e
+	}
+	e.calls++
+	if target != "" {
+		u.targetStatus(e, target).calls++
+	}
+	u.m.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { u.release(ui, base, target) })
+	}
+}
+
+func (u *uiStatusTracker) release(ui, base terminal.UI, target string) {
+	u.m.Lock()
+	defer u.m.Unlock()
+
+	e := u.active[base]
+	if ts, ok := e.targets[target]; ok {
+		ts.calls--
+		if ts.calls < 1 {
+			delete(e.targets, target)
+			ts.Close()
+		}
+	}
+
+	e.calls--
+	if e.calls > 0 {
+		return
+	}
+
+	delete(u.active, base)
+	for _, ts := range e.targets {
+		ts.Close()
+	}
+	if e.group != nil {
+		e.group.Wait()
+	}
+	if tu, ok := ui.(*targetUI); ok {
+		ui = tu.UI
+	}
+	closeUIStatus(ui)
+}
+
+// Status of the target using the UI. If the UI does not provide
+// step groups the status of the UI is returned.
+func (u *uiStatusTracker) status(ui terminal.UI, target string) terminal.Status {
+	base := baseUI(ui)
+
+	u.m.Lock()
+	defer u.m.Unlock()
+
+	e, ok := u.active[base]
+	if !ok {
+		return ui.Status()
+	}
+
+	// The step group is only created once a status is requested
+	if !e.grouped {
+		e.grouped = true
+		if g := ui.StepGroup(); g != nil {
+			if v := reflect.ValueOf(g); v.Kind() != reflect.Ptr || !v.IsNil() {
+				e.group = g
+			}
+		}
+	}
+
+	ts := u.targetStatus(e, target)
+	ts.group = e.group
+	if ts.started() {
+		return ts
+	}
+
+	return ui.Status()
+}
+
+// Returns the status of the target, creating it if needed. This
+// must be called with the lock held.
+func (u *uiStatusTracker) targetStatus(e *uiStatusEntry, target string) *targetStatus {
+	ts, ok := e.targets[target]
+	if !ok {
+		ts = &targetStatus{target: target}
+		e.targets[target] = ts
+	}
+
+	return ts
+}
+
+// targetStatus is the status of a single target. It is
+// displayed using a step of the UI step group.
+type targetStatus struct {
+	calls  int                // number of calls by the target
+	group  terminal.StepGroup // group the step is added to
+	step   terminal.Step      // step displaying the status
+	target string             // name of the target
+
+	m sync.Mutex
+}
+
+// Start a step for the status if one is not running. Returns
+// false if the UI does not provide step groups.
+func (s *targetStatus) started() bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.step == nil && s.group != nil {
+		s.step = s.group.Add("%s", s.target)
+	}
+
+	return s.step != nil
+}
+
+// Update implements terminal.Status
+func (s *targetStatus) Update(msg string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.step != nil {
+		s.step.Update("%s", msg)
+	}
+}
+
+// Step implements terminal.Status
+func (s *targetStatus) Step(status, msg string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.step != nil {
+		s.step.Update("%s", msg)
+		s.step.Status(status)
+	}
+}
+
+// Close implements terminal.Status. A new step is
+// started if the target status is used again.
+func (s *targetStatus) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.step != nil {
+		s.step.Done()
+		s.step = nil
+	}
+
+	return nil
+}
+
+// targetUI wraps the UI used by a target so the
+// target is provided its own status
+type targetUI struct {
+	terminal.UI
+
+	target  string           // name of the target
+	tracker *uiStatusTracker // tracker providing the status
+}
+
+func newTargetUI(ui terminal.UI, tracker *uiStatusTracker, target string) *targetUI {
+	return &targetUI{UI: ui, target: target, tracker: tracker}
+}
+
+// Status implements terminal.UI
+func (u *targetUI) Status() terminal.Status {
+	return u.tracker.status(u.UI, u.target)
+}
+
+func (u *targetUI) unwrapUI() terminal.UI {
+	return u.UI
+}
+
+// Close the status of the UI. A terminal.UI is expected to
+// return a usable status from Status(), but minimal UIs which
+// do not support status output may return nil. Those UIs have
+// no status to close so they are ignored.
+func closeUIStatus(ui terminal.UI) {
+	s := ui.Status()
+	if s == nil {
+		return
+	}
+	if v := reflect.ValueOf(s); v.Kind() == reflect.Ptr && v.IsNil() {
+		return
+	}
+
+	s.Close()
+}
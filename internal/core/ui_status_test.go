@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+)
+
+type testStatusUI struct {
+	terminal.UI
+	status *testStatus
+}
+
+func (u *testStatusUI) Status() terminal.Status {
+	return u.status
+}
+
+type nilStatusUI struct {
+	terminal.UI
+}
+
+func (u *nilStatusUI) Status() terminal.Status {
+	return nil
+}
+
+type testStatus struct {
+	terminal.Status
+	closed int32
+}
+
+func (s *testStatus) Close() error {
+	atomic.AddInt32(&s.closed, 1)
+	return nil
+}
+
+func TestUIStatusTrackerConcurrent(t *testing.T) {
+	ui := &testStatusUI{status: &testStatus{}}
+	tracker := newUIStatusTracker()
+
+	first := tracker.acquire(ui)
+	started := make(chan struct{})
+	finish := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer tracker.acquire(ui)()
+		close(started)
+		<-finish
+	}()
+
+	<-started
+	first()
+	require.Equal(t, int32(0), atomic.LoadInt32(&ui.status.closed),
+		"status closed while another call is active")
+
+	close(finish)
+	wg.Wait()
+	require.Equal(t, int32(1), atomic.LoadInt32(&ui.status.closed))
+
+	// Releasing multiple times should not close again
+	first()
+	require.Equal(t, int32(1), atomic.LoadInt32(&ui.status.closed))
+}
+
+func TestUIStatusNil(t *testing.T) {
+	fn := func() int32 { return 1 }
+
+	for _, ui := range []terminal.UI{
+		&nilStatusUI{},
+		&testStatusUI{},
+	} {
+		b := TestBasis(t)
+		b.ui = ui
+
+		require.NotPanics(t, func() {
+			result, err := b.callDynamicFunc(context.Background(), b.logger, fn, (*int32)(nil))
+			require.NoError(t, err)
+			require.Equal(t, int32(1), result)
+		})
+	}
+}
+
+type testStepGroupUI struct {
+	testStatusUI
+	group *testStepGroup
+}
+
+func (u *testStepGroupUI) StepGroup() terminal.StepGroup {
+	if u.group == nil {
+		return nil
+	}
+	return u.group
+}
+
+type testStepGroup struct {
+	steps  []*testStep
+	waited int32
+
+	m sync.Mutex
+}
+
+func (g *testStepGroup) Add(msg string, args ...interface{}) terminal.Step {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	s := &testStep{msg: fmt.Sprintf(msg, args...)}
+	g.steps = append(g.steps, s)
+	return s
+}
+
+func (g *testStepGroup) Wait() {
+	atomic.AddInt32(&g.waited, 1)
+}
+
+type testStep struct {
+	terminal.Step
+	msg     string
+	updates []string
+	done    bool
+}
+
+func (s *testStep) Update(msg string, args ...interface{}) {
+	s.updates = append(s.updates, fmt.Sprintf(msg, args...))
+}
+
+func (s *testStep) Status(string) {}
+
+func (s *testStep) Done() {
+	s.done = true
+}
+
+func TestUIStatusTrackerWrappedUI(t *testing.T) {
+	ui := &testStatusUI{status: &testStatus{}}
+	tracker := newUIStatusTracker()
+
+	first := tracker.acquire(newFilterUI(ui, strings.ToUpper))
+	second := tracker.acquire(newCaptureUI(ui, newOutputBuffer(10)))
+	require.Len(t, tracker.active, 1)
+
+	first()
+	require.Equal(t, int32(0), atomic.LoadInt32(&ui.status.closed),
+		"status closed while another wrapper is active")
+
+	second()
+	require.Equal(t, int32(1), atomic.LoadInt32(&ui.status.closed))
+	require.Empty(t, tracker.active)
+}
+
+func TestUIStatusTrackerTargets(t *testing.T) {
+	group := &testStepGroup{}
+	ui := &testStepGroupUI{
+		testStatusUI: testStatusUI{status: &testStatus{}},
+		group:        group,
+	}
+	tracker := newUIStatusTracker()
+	web := newTargetUI(ui, tracker, "web")
+	db := newTargetUI(ui, tracker, "db")
+
+	releaseWeb := tracker.acquire(web)
+	releaseDB := tracker.acquire(db)
+
+	// Each target is provided its own status
+	web.Status().Update("booting web")
+	db.Status().Update("booting db")
+	require.Len(t, group.steps, 2)
+	require.Equal(t, "web", group.steps[0].msg)
+	require.Equal(t, []string{"booting web"}, group.steps[0].updates)
+	require.Equal(t, "db", group.steps[1].msg)
+	require.Equal(t, []string{"booting db"}, group.steps[1].updates)
+
+	// Completing one target does not close the status of the other
+	releaseWeb()
+	require.True(t, group.steps[0].done)
+	require.False(t, group.steps[1].done)
+	require.Equal(t, int32(0), atomic.LoadInt32(&group.waited))
+	db.Status().Update("provisioning db")
+	require.Len(t, group.steps, 2)
+	require.Equal(t, "provisioning db", group.steps[1].updates[1])
+
+	releaseDB()
+	require.True(t, group.steps[1].done)
+	require.Equal(t, int32(1), atomic.LoadInt32(&group.waited))
+	require.Equal(t, int32(1), atomic.LoadInt32(&ui.status.closed))
+}
+
+func TestUIStatusTrackerTargetsUngrouped(t *testing.T) {
+	ui := &testStepGroupUI{testStatusUI: testStatusUI{status: &testStatus{}}}
+	tracker := newUIStatusTracker()
+	web := newTargetUI(ui, tracker, "web")
+	db := newTargetUI(ui, tracker, "db")
+
+	releaseWeb := tracker.acquire(web)
+	releaseDB := tracker.acquire(db)
+
+	// Targets share the status of the UI
+	require.Same(t, ui.status, web.Status())
+	require.Same(t, ui.status, db.Status())
+
+	releaseWeb()
+	require.Equal(t, int32(0), atomic.LoadInt32(&ui.status.closed))
+	releaseDB()
+	require.Equal(t, int32(1), atomic.LoadInt32(&ui.status.closed))
+}
+
+func TestTargetStatusUI(t *testing.T) {
+	tt := TestMinimalTarget(t)
+	tu, ok := tt.ui.(*targetUI)
+	require.True(t, ok)
+	require.Equal(t, tt.target.Name, tu.target)
+	require.Same(t, baseUI(tt.project.ui), baseUI(tt.ui))
+}
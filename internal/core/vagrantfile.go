@@ -159,6 +159,7 @@ type Vagrantfile struct {
 	root          *component.ConfigData           // Combined Vagrantfile config
 	rubyClient    *serverclient.RubyVagrantClient // Client for the Ruby runtime
 	sources       map[LoadLocation]*source        // Vagrantfile sources
+	targets       *targetLookup                   // Names of targets found by name or id
 
 	targetSource *vagrant_plugin_sdk.Ref_Project
 
@@ -200,6 +201,7 @@ func NewVagrantfile(
 		registrations: make(registrations),
 		rubyClient:    f.plugins.RubyClient(),
 		sources:       make(map[LoadLocation]*source),
+		targets:       newTargetLookup(),
 	}
 	int := plugin.NewInternal(
 		f.plugins.LegacyBroker(),
@@ -1409,6 +1411,7 @@ func (v *Vagrantfile) clone(name string) *Vagrantfile {
 		rubyClient:    v.rubyClient,
 		sources:       srcs,
 		targetSource:  v.targetSource,
+		targets:       v.targets,
 	}
 
 	v.Closer(func() error { return newV.Close() })
@@ -1451,8 +1454,8 @@ func (v *Vagrantfile) toProto(
 func (v *Vagrantfile) targetNameLookup(
 	nameOrId string, // target name or resource id
 ) (string, error) {
-	if cname, ok := v.cache.Fetch("lookup" + nameOrId); ok {
-		return cname.(string), nil
+	if name, ok := v.targets.get(nameOrId); ok {
+		return name, nil
 	}
 
 	// Run a lookup first to verify if this target actually exists. If it does,
@@ -1482,20 +1485,20 @@ func (v *Vagrantfile) targetNameLookup(
 		return "", err
 	}
 
-	// Register lookups in the local cache
-	v.cache.Register(
-		fmt.Sprintf("lookup+%s", resp.Target.Name),
-		resp.Target.Name,
-	)
-
-	v.cache.Register(
-		fmt.Sprintf("lookup+%s", resp.Target.ResourceId),
-		resp.Target.Name,
-	)
+	// Index the target so later lookups are resolved locally
+	v.targets.add(resp.Target.Name, resp.Target.ResourceId)
 
 	return resp.Target.Name, nil
 }
 
+// Remove a deleted target from the lookup index
+func (v *Vagrantfile) forgetTarget(
+	name, // name of the target
+	resourceId string, // resource id of the target
+) {
+	v.targets.remove(name, resourceId)
+}
+
 func (v *Vagrantfile) loadToRoot(
 	value *vagrant_plugin_sdk.Args_ConfigData,
 ) error {
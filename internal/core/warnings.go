@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+)
+
+// Warnings collects non-fatal warnings reported while running
+// a command. A collector is provided to commands as a typed
+// argument so plugins can report warnings (like deprecations)
+// which are displayed separately from normal output. Plugins
+// running in their own process request commandargs.Warnings.
+type Warnings struct {
+	warnings []string
+
+	m sync.Mutex
+}
+
+// NewWarnings creates a new empty warnings collector
+func NewWarnings() *Warnings {
+	return &Warnings{warnings: []string{}}
+}
+
+// Add records a new warning
+func (w *Warnings) Add(msg string, args ...interface{}) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+	w.warnings = append(w.warnings, msg)
+}
+
+// Warnings returns all recorded warnings
+func (w *Warnings) Warnings() []string {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	result := make([]string, len(w.warnings))
+	copy(result, w.warnings)
+
+	return result
+}
+
+// Display all recorded warnings using the warning style
+func (w *Warnings) display(ui terminal.UI) {
+	if ui == nil {
+		return
+	}
+
+	for _, msg := range w.Warnings() {
+		ui.Output(msg, terminal.WithWarningStyle())
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package core
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+)
+
+type testStyleUI struct {
+	terminal.UI
+	styles []string
+}
+
+func (u *testStyleUI) Output(msg string, raw ...interface{}) {
+	_, style, _, _, _ := terminal.Interpret(msg, raw...)
+	u.styles = append(u.styles, style)
+}
+
+func TestWarnings(t *testing.T) {
+	w := NewWarnings()
+	w.Add("plain warning")
+	w.Add("option %q is deprecated", "foo")
+
+	result := w.Warnings()
+	require.Equal(t, []string{"plain warning", "option \"foo\" is deprecated"}, result)
+
+	// Modifying the result should not modify the collector
+	result[0] = "modified"
+	require.Equal(t, "plain warning", w.Warnings()[0])
+
+	ui := &testStyleUI{}
+	w.display(ui)
+	require.Equal(t, []string{terminal.WarningStyle, terminal.WarningStyle}, ui.styles)
+}
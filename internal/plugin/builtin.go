@@ -15,7 +15,10 @@ import (
 
 	sdk "github.com/hashicorp/vagrant-plugin-sdk"
 	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cleanup"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/pluginclient"
+
+	"github.com/hashicorp/vagrant/internal/version"
 )
 
 type Builtin struct {
@@ -133,6 +136,8 @@ func (b *Builtin) Factory(name string) PluginRegistration {
 			Location: fmt.Sprintf("builtin::%s", name),
 			Name:     info.Name(),
 			Types:    info.ComponentTypes(),
+			Version:  version.GetVersion().FullVersionNumber(false),
+			cleaner:  cleanup.New(),
 			logger:   log,
 			src:      client,
 		}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// Artifacts collects files produced by a command, like logs or
+// exported boxes. Collected artifacts are recorded by the basis
+// once the command completes.
+type Artifacts interface {
+	// Add records a new artifact at the given path
+	Add(path string, metadata map[string]string) error
+}
+
+// ArtifactsProto serves the artifacts collector so it can be
+// provided to plugins
+func ArtifactsProto(
+	a Artifacts,
+	internal Internal,
+) (*vagrant_command.Artifacts, error) {
+	id, err := serve(internal, a, func(s *grpc.Server) {
+		vagrant_command.RegisterArtifactsServiceServer(s, &artifactsServer{impl: a})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Artifacts{StreamId: id}, nil
+}
+
+// ArtifactsFromProto connects to the artifacts collector served
+// by core
+func ArtifactsFromProto(
+	input *vagrant_command.Artifacts,
+	internal Internal,
+) (Artifacts, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &artifactsClient{
+		client: vagrant_command.NewArtifactsServiceClient(conn),
+	}, nil
+}
+
+type artifactsClient struct {
+	client vagrant_command.ArtifactsServiceClient
+}
+
+// Add implements Artifacts. Relative paths are expanded using
+// the working directory of the plugin since it may differ from
+// the working directory of core.
+func (c *artifactsClient) Add(path string, metadata map[string]string) (err error) {
+	if path != "" {
+		if path, err = filepath.Abs(path); err != nil {
+			return
+		}
+	}
+
+	_, err = c.client.Add(context.Background(),
+		&vagrant_command.Artifacts_AddRequest{
+			Path:     path,
+			Metadata: metadata,
+		},
+	)
+
+	return
+}
+
+type artifactsServer struct {
+	impl Artifacts
+}
+
+func (s *artifactsServer) Add(
+	ctx context.Context,
+	req *vagrant_command.Artifacts_AddRequest,
+) (*emptypb.Empty, error) {
+	if err := s.impl.Add(req.Path, req.Metadata); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// CancelCleanupFunc rolls back partial changes made by a command
+// which was cancelled. The provided context is not the cancelled
+// command context and will be cancelled when the cleanup timeout
+// is reached.
+type CancelCleanupFunc func(context.Context) error
+
+// CancelCleanup collects callbacks which are run if the command
+// is cancelled
+type CancelCleanup interface {
+	// Register adds a callback to be run if the command is cancelled
+	Register(fn CancelCleanupFunc)
+}
+
+// CancelCleanupProto serves the cleanup collector so it can be
+// provided to plugins
+func CancelCleanupProto(
+	c CancelCleanup,
+	internal Internal,
+) (*vagrant_command.CancelCleanup, error) {
+	id, err := serve(internal, c, func(s *grpc.Server) {
+		vagrant_command.RegisterCancelCleanupServiceServer(s,
+			&cancelCleanupServer{impl: c, internal: internal})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.CancelCleanup{StreamId: id}, nil
+}
+
+// CancelCleanupFromProto connects to the cleanup collector served
+// by core
+func CancelCleanupFromProto(
+	input *vagrant_command.CancelCleanup,
+	internal Internal,
+) (CancelCleanup, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cancelCleanupClient{
+		client:   vagrant_command.NewCancelCleanupServiceClient(conn),
+		internal: internal,
+	}, nil
+}
+
+type cancelCleanupClient struct {
+	client   vagrant_command.CancelCleanupServiceClient
+	internal Internal
+}
+
+// Register implements CancelCleanup. The callback is served by
+// the plugin and called by core if the command is cancelled.
+func (c *cancelCleanupClient) Register(fn CancelCleanupFunc) {
+	f := &cancelCleanupFuncServer{fn: fn}
+	id, err := serve(c.internal, f, func(s *grpc.Server) {
+		vagrant_command.RegisterCancelCleanupFuncServiceServer(s, f)
+	})
+	if err == nil {
+		_, err = c.client.Register(context.Background(),
+			&vagrant_command.CancelCleanup_RegisterRequest{StreamId: id})
+	}
+	if err != nil {
+		c.internal.Logger().Error("failed to register cancel cleanup",
+			"error", err,
+		)
+	}
+}
+
+type cancelCleanupServer struct {
+	impl     CancelCleanup
+	internal Internal
+}
+
+func (s *cancelCleanupServer) Register(
+	ctx context.Context,
+	req *vagrant_command.CancelCleanup_RegisterRequest,
+) (*emptypb.Empty, error) {
+	// Connect now since the plugin only announces the stream for
+	// a short time, and the callback may not be called until later
+	conn, err := dial(s.internal, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+	client := vagrant_command.NewCancelCleanupFuncServiceClient(conn)
+
+	s.impl.Register(func(ctx context.Context) error {
+		_, err := client.Cleanup(ctx, &emptypb.Empty{})
+		return err
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+type cancelCleanupFuncServer struct {
+	fn CancelCleanupFunc
+}
+
+func (s *cancelCleanupFuncServer) Cleanup(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*emptypb.Empty, error) {
+	if err := s.fn(ctx); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package commandargs provides the typed arguments core supplies to
+// commands running in plugin processes. Core serves each argument
+// using the plugin broker, and plugins receive clients implementing
+// the interfaces defined in this package. Plugins include Mappers
+// in their mappers (sdk.WithMappers) to request the arguments.
+package commandargs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cacher"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cleanup"
+	"google.golang.org/grpc"
+)
+
+// ErrNoBroker is returned when an argument is mapped without
+// a plugin broker to serve or connect through
+var ErrNoBroker = errors.New("plugin broker is not available")
+
+// Mappers convert the arguments received by plugins into clients
+var Mappers = []interface{}{
+	ArtifactsFromProto,
+	CancelCleanupFromProto,
+	CredentialsFromProto,
+	InvokerFromProto,
+	ProgressFromProto,
+	ResolvedConfigFromProto,
+	PrompterFromProto,
+	StdinFromProto,
+	WarningsFromProto,
+}
+
+// Internal matches the internal value the plugin SDK provides to
+// mappers. Only the parts used to serve and connect to arguments
+// are included.
+type Internal interface {
+	Broker() *plugin.GRPCBroker
+	Cache() cacher.Cache
+	Cleanup() cleanup.Cleanup
+	Logger() hclog.Logger
+}
+
+// Error returned by core which wraps a known error. The message
+// is kept as is and the known error can be checked using errors.Is.
+type remoteError struct {
+	msg string
+	err error
+}
+
+func (e *remoteError) Error() string {
+	return e.msg
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.err
+}
+
+// Serve the value using a new broker stream. The services are
+// registered on the server by the register function. Values are
+// only served once and the stream is stopped when the internal
+// value is cleaned up.
+func serve(
+	internal Internal, // internal value from the mapper
+	impl interface{}, // value being served
+	register func(*grpc.Server), // registers services for the value
+) (uint32, error) {
+	broker := internal.Broker()
+	if broker == nil {
+		return 0, ErrNoBroker
+	}
+
+	key := fmt.Sprintf("commandargs-%T-%p", impl, impl)
+	if id := internal.Cache().Get(key); id != nil {
+		return id.(uint32), nil
+	}
+
+	id := broker.NextId()
+	l, err := broker.Accept(id)
+	if err != nil {
+		return 0, err
+	}
+
+	s := plugin.DefaultGRPCServer(nil)
+	register(s)
+	go s.Serve(l)
+
+	internal.Logger().Trace("serving command argument",
+		"argument", hclog.Fmt("%T", impl),
+		"stream_id", id,
+	)
+
+	internal.Cleanup().Do(func() error {
+		s.Stop()
+		return nil
+	})
+	internal.Cache().Register(key, id)
+
+	return id, nil
+}
+
+// Connect to a value served on the broker stream
+func dial(internal Internal, id uint32) (*grpc.ClientConn, error) {
+	broker := internal.Broker()
+	if broker == nil {
+		return nil, ErrNoBroker
+	}
+
+	conn, err := broker.Dial(id)
+	if err != nil {
+		return nil, err
+	}
+	internal.Cleanup().Do(conn.Close)
+
+	return conn, nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// Redacted is the value displayed in place of a secret
+const Redacted = "[redacted]"
+
+// ErrCredentialNotFound is returned when no secret exists for
+// the requested name
+var ErrCredentialNotFound = errors.New("credential not found")
+
+// Secret is a credential value. When formatted for output the
+// value is redacted so it is never included in logs or UI output.
+// Convert to a string to access the actual value.
+type Secret string
+
+// String implements fmt.Stringer
+func (s Secret) String() string {
+	return Redacted
+}
+
+// GoString implements fmt.GoStringer
+func (s Secret) GoString() string {
+	return Redacted
+}
+
+// MarshalText implements encoding.TextMarshaler so the value is
+// redacted by JSON formatted loggers
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(Redacted), nil
+}
+
+// Credentials supplies secrets, like cloud API keys, to commands
+type Credentials interface {
+	// Get returns the secret for the given name
+	Get(name string) (Secret, error)
+}
+
+// CredentialsProto serves the credentials so they can be provided
+// to plugins. Secrets are only sent when a plugin requests them.
+func CredentialsProto(
+	c Credentials,
+	internal Internal,
+) (*vagrant_command.Credentials, error) {
+	id, err := serve(internal, c, func(s *grpc.Server) {
+		vagrant_command.RegisterCredentialsServiceServer(s, &credentialsServer{impl: c})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Credentials{StreamId: id}, nil
+}
+
+// CredentialsFromProto connects to the credentials served by core
+func CredentialsFromProto(
+	input *vagrant_command.Credentials,
+	internal Internal,
+) (Credentials, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialsClient{
+		client: vagrant_command.NewCredentialsServiceClient(conn),
+	}, nil
+}
+
+type credentialsClient struct {
+	client vagrant_command.CredentialsServiceClient
+}
+
+// Get implements Credentials
+func (c *credentialsClient) Get(name string) (Secret, error) {
+	resp, err := c.client.Get(context.Background(),
+		&vagrant_command.Credentials_GetRequest{Name: name})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", &remoteError{
+				msg: status.Convert(err).Message(),
+				err: ErrCredentialNotFound,
+			}
+		}
+
+		return "", err
+	}
+
+	return Secret(resp.Secret), nil
+}
+
+// String implements fmt.Stringer
+func (c *credentialsClient) String() string {
+	return "Credentials(" + Redacted + ")"
+}
+
+// GoString implements fmt.GoStringer
+func (c *credentialsClient) GoString() string {
+	return c.String()
+}
+
+type credentialsServer struct {
+	impl Credentials
+}
+
+func (s *credentialsServer) Get(
+	ctx context.Context,
+	req *vagrant_command.Credentials_GetRequest,
+) (*vagrant_command.Credentials_GetResponse, error) {
+	secret, err := s.impl.Get(req.Name)
+	if err != nil {
+		if errors.Is(err, ErrCredentialNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return &vagrant_command.Credentials_GetResponse{Secret: string(secret)}, nil
+}
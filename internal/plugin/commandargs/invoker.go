@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// Invoker allows a running command to run other commands within
+// the same scope
+type Invoker interface {
+	// Run runs the command with the given arguments and returns
+	// any warnings reported by the command
+	Run(command string, args *vagrant_plugin_sdk.Command_Arguments) ([]string, error)
+}
+
+// InvokerProto serves the invoker so it can be provided to plugins
+func InvokerProto(
+	i Invoker,
+	internal Internal,
+) (*vagrant_command.Invoker, error) {
+	id, err := serve(internal, i, func(s *grpc.Server) {
+		vagrant_command.RegisterInvokerServiceServer(s, &invokerServer{impl: i})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Invoker{StreamId: id}, nil
+}
+
+// InvokerFromProto connects to the invoker served by core
+func InvokerFromProto(
+	input *vagrant_command.Invoker,
+	internal Internal,
+) (Invoker, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invokerClient{
+		client: vagrant_command.NewInvokerServiceClient(conn),
+	}, nil
+}
+
+type invokerClient struct {
+	client vagrant_command.InvokerServiceClient
+}
+
+// Run implements Invoker
+func (c *invokerClient) Run(
+	command string,
+	args *vagrant_plugin_sdk.Command_Arguments,
+) ([]string, error) {
+	resp, err := c.client.Run(context.Background(),
+		&vagrant_command.Invoker_RunRequest{
+			Command: command,
+			Args:    args,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Warnings, nil
+}
+
+type invokerServer struct {
+	impl Invoker
+}
+
+func (s *invokerServer) Run(
+	ctx context.Context,
+	req *vagrant_command.Invoker_RunRequest,
+) (*vagrant_command.Invoker_RunResponse, error) {
+	warnings, err := s.impl.Run(req.Command, req.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Invoker_RunResponse{Warnings: warnings}, nil
+}
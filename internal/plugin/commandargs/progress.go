@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// ProgressState is the most recent progress reported by a command
+type ProgressState struct {
+	Step    string  // description of the current step
+	Percent float64 // percent complete, negative if unknown
+}
+
+// Progress allows commands to report the progress of long
+// running work, like box downloads or multi-step provisioning
+type Progress interface {
+	// Update reports the current step and percent complete. A
+	// negative percent indicates the completion of the step is
+	// unknown.
+	Update(step string, percent float64)
+	// State returns the most recent progress reported, or nil
+	// if no progress has been reported
+	State() *ProgressState
+}
+
+// ProgressProto serves the progress so it can be provided
+// to plugins
+func ProgressProto(
+	p Progress,
+	internal Internal,
+) (*vagrant_command.Progress, error) {
+	id, err := serve(internal, p, func(s *grpc.Server) {
+		vagrant_command.RegisterProgressServiceServer(s, &progressServer{impl: p})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Progress{StreamId: id}, nil
+}
+
+// ProgressFromProto connects to the progress served by core
+func ProgressFromProto(
+	input *vagrant_command.Progress,
+	internal Internal,
+) (Progress, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &progressClient{
+		client: vagrant_command.NewProgressServiceClient(conn),
+		logger: internal.Logger(),
+	}, nil
+}
+
+type progressClient struct {
+	client vagrant_command.ProgressServiceClient
+	logger hclog.Logger
+}
+
+// Update implements Progress
+func (c *progressClient) Update(step string, percent float64) {
+	_, err := c.client.Update(context.Background(),
+		&vagrant_command.Progress_State{
+			Step:    step,
+			Percent: percent,
+		},
+	)
+	if err != nil {
+		c.logger.Error("failed to report progress",
+			"step", step,
+			"error", err,
+		)
+	}
+}
+
+// State implements Progress
+func (c *progressClient) State() *ProgressState {
+	resp, err := c.client.State(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		c.logger.Error("failed to get progress",
+			"error", err,
+		)
+
+		return nil
+	}
+	if resp.State == nil {
+		return nil
+	}
+
+	return &ProgressState{
+		Step:    resp.State.Step,
+		Percent: resp.State.Percent,
+	}
+}
+
+type progressServer struct {
+	impl Progress
+}
+
+func (s *progressServer) Update(
+	ctx context.Context,
+	req *vagrant_command.Progress_State,
+) (*emptypb.Empty, error) {
+	s.impl.Update(req.Step, req.Percent)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *progressServer) State(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*vagrant_command.Progress_StateResponse, error) {
+	resp := &vagrant_command.Progress_StateResponse{}
+	if state := s.impl.State(); state != nil {
+		resp.State = &vagrant_command.Progress_State{
+			Step:    state.Step,
+			Percent: state.Percent,
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/terminal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// InputRequest describes a value requested from the user
+type InputRequest struct {
+	Prompt  string // prompt displayed to the user
+	Secret  bool   // mask the input
+	Default string // value used when input cannot be requested
+}
+
+// Prompter allows commands to request input from the user
+// through the core UI
+type Prompter interface {
+	// Input requests a value from the user. When input cannot
+	// be requested and the request has no default value, the
+	// error wraps terminal.ErrNonInteractive.
+	Input(req *InputRequest) (string, error)
+}
+
+// PrompterProto serves the prompter so it can be provided
+// to plugins
+func PrompterProto(
+	p Prompter,
+	internal Internal,
+) (*vagrant_command.Prompter, error) {
+	id, err := serve(internal, p, func(s *grpc.Server) {
+		vagrant_command.RegisterPrompterServiceServer(s, &prompterServer{impl: p})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Prompter{StreamId: id}, nil
+}
+
+// PrompterFromProto connects to the prompter served by core
+func PrompterFromProto(
+	input *vagrant_command.Prompter,
+	internal Internal,
+) (Prompter, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &prompterClient{
+		client: vagrant_command.NewPrompterServiceClient(conn),
+	}, nil
+}
+
+type prompterClient struct {
+	client vagrant_command.PrompterServiceClient
+}
+
+// Input implements Prompter
+func (c *prompterClient) Input(req *InputRequest) (string, error) {
+	if req == nil {
+		req = &InputRequest{}
+	}
+
+	resp, err := c.client.Input(context.Background(),
+		&vagrant_command.Prompter_InputRequest{
+			Prompt:  req.Prompt,
+			Secret:  req.Secret,
+			Default: req.Default,
+		},
+	)
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return "", &remoteError{
+				msg: status.Convert(err).Message(),
+				err: terminal.ErrNonInteractive,
+			}
+		}
+
+		return "", err
+	}
+
+	return resp.Value, nil
+}
+
+type prompterServer struct {
+	impl Prompter
+}
+
+func (s *prompterServer) Input(
+	ctx context.Context,
+	req *vagrant_command.Prompter_InputRequest,
+) (*vagrant_command.Prompter_InputResponse, error) {
+	value, err := s.impl.Input(&InputRequest{
+		Prompt:  req.Prompt,
+		Secret:  req.Secret,
+		Default: req.Default,
+	})
+	if err != nil {
+		// Non-interactive errors are identified by the status
+		// code so the client can return the same error
+		if errors.Is(err, terminal.ErrNonInteractive) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return &vagrant_command.Prompter_InputResponse{Value: value}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// ResolvedConfig is a read-only view of the effective configuration
+// of the basis. All configuration sources have been merged and values
+// have been interpolated.
+type ResolvedConfig struct {
+	input *vagrant_command.ResolvedConfig
+}
+
+// ResolvedConfigFromProto provides the configuration to plugins
+func ResolvedConfigFromProto(
+	input *vagrant_command.ResolvedConfig,
+) *ResolvedConfig {
+	return &ResolvedConfig{input: input}
+}
+
+// RunnerEnabled returns if runners are enabled
+func (r *ResolvedConfig) RunnerEnabled() bool {
+	return r.input.RunnerEnabled
+}
+
+// Label returns the value of the label and if it was set
+func (r *ResolvedConfig) Label(key string) (string, bool) {
+	v, ok := r.input.Labels[key]
+	return v, ok
+}
+
+// Labels returns a copy of all labels
+func (r *ResolvedConfig) Labels() map[string]string {
+	result := make(map[string]string, len(r.input.Labels))
+	for k, v := range r.input.Labels {
+		result[k] = v
+	}
+
+	return result
+}
+
+// PluginPolicy returns the names of plugins which are allowed
+// and denied. If no restrictions are configured ok is false.
+func (r *ResolvedConfig) PluginPolicy() (allow, deny []string, ok bool) {
+	p := r.input.PluginPolicy
+	if p == nil {
+		return nil, nil, false
+	}
+
+	return append([]string{}, p.Allow...), append([]string{}, p.Deny...), true
+}
+
+// ComponentConfig returns the configuration values defined for
+// the component with the given type and plugin name. If no
+// configuration is defined nil is returned.
+func (r *ResolvedConfig) ComponentConfig(
+	typ component.Type, // type of component
+	name string, // name of the plugin
+) (map[string]interface{}, error) {
+	for _, c := range r.input.Components {
+		if !strings.EqualFold(c.Type, typ.String()) || c.Name != name {
+			continue
+		}
+		if c.Error != "" {
+			return nil, fmt.Errorf("invalid configuration for %s %s: %s",
+				typ.String(), name, c.Error)
+		}
+
+		return c.Values.AsMap(), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// Maximum number of bytes sent in a single read response
+const maxStdinRead = 32 * 1024
+
+// Stdin provides the user's input to commands. When no input is
+// attached, reads return io.EOF immediately.
+type Stdin interface {
+	io.Reader
+
+	// Interactive returns if the input is a terminal
+	Interactive() bool
+	// MakeRaw puts the terminal into raw mode
+	MakeRaw() error
+	// Restore returns the terminal to the mode it was in
+	// before MakeRaw was called
+	Restore() error
+}
+
+// StdinProto serves the input so it can be provided to plugins
+func StdinProto(
+	in Stdin,
+	internal Internal,
+) (*vagrant_command.Stdin, error) {
+	id, err := serve(internal, in, func(s *grpc.Server) {
+		vagrant_command.RegisterStdinServiceServer(s, &stdinServer{impl: in})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Stdin{StreamId: id}, nil
+}
+
+// StdinFromProto connects to the input served by core
+func StdinFromProto(
+	input *vagrant_command.Stdin,
+	internal Internal,
+) (Stdin, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stdinClient{
+		client: vagrant_command.NewStdinServiceClient(conn),
+	}, nil
+}
+
+type stdinClient struct {
+	client vagrant_command.StdinServiceClient
+	eof    bool
+	stream vagrant_command.StdinService_ReadClient
+
+	m sync.Mutex
+}
+
+// Read implements io.Reader. The read stream is opened on the
+// first read and reused for all following reads.
+func (c *stdinClient) Read(p []byte) (int, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.eof {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if c.stream == nil {
+		stream, err := c.client.Read(context.Background())
+		if err != nil {
+			return 0, err
+		}
+		c.stream = stream
+	}
+
+	size := len(p)
+	if size > maxStdinRead {
+		size = maxStdinRead
+	}
+	if err := c.stream.Send(&vagrant_command.Stdin_ReadRequest{Size: uint32(size)}); err != nil {
+		return 0, err
+	}
+	resp, err := c.stream.Recv()
+	if err != nil {
+		return 0, err
+	}
+
+	n := copy(p, resp.Data)
+	if resp.Eof {
+		c.eof = true
+		c.stream.CloseSend()
+		if n == 0 {
+			return 0, io.EOF
+		}
+	}
+
+	return n, nil
+}
+
+// Interactive implements Stdin
+func (c *stdinClient) Interactive() bool {
+	resp, err := c.client.Interactive(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return false
+	}
+
+	return resp.Interactive
+}
+
+// MakeRaw implements Stdin
+func (c *stdinClient) MakeRaw() error {
+	_, err := c.client.MakeRaw(context.Background(), &emptypb.Empty{})
+	return err
+}
+
+// Restore implements Stdin
+func (c *stdinClient) Restore() error {
+	_, err := c.client.Restore(context.Background(), &emptypb.Empty{})
+	return err
+}
+
+type stdinServer struct {
+	impl Stdin
+}
+
+func (s *stdinServer) Read(stream vagrant_command.StdinService_ReadServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		size := int(req.Size)
+		if size > maxStdinRead {
+			size = maxStdinRead
+		}
+		buf := make([]byte, size)
+		n, err := s.impl.Read(buf)
+		eof := errors.Is(err, io.EOF)
+		if err != nil && !eof {
+			return err
+		}
+
+		if err = stream.Send(&vagrant_command.Stdin_ReadResponse{
+			Data: buf[:n],
+			Eof:  eof,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *stdinServer) Interactive(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*vagrant_command.Stdin_InteractiveResponse, error) {
+	return &vagrant_command.Stdin_InteractiveResponse{
+		Interactive: s.impl.Interactive(),
+	}, nil
+}
+
+func (s *stdinServer) MakeRaw(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*emptypb.Empty, error) {
+	if err := s.impl.MakeRaw(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *stdinServer) Restore(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*emptypb.Empty, error) {
+	if err := s.impl.Restore(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
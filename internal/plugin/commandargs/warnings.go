@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandargs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hashicorp/vagrant/internal/plugin/proto/vagrant_command"
+)
+
+// Warnings collects non-fatal warnings reported by a command.
+// Warnings are displayed separately from normal output once the
+// command completes.
+type Warnings interface {
+	// Add records a new warning
+	Add(msg string, args ...interface{})
+}
+
+// WarningsProto serves the warnings collector so it can be
+// provided to plugins
+func WarningsProto(
+	w Warnings,
+	internal Internal,
+) (*vagrant_command.Warnings, error) {
+	id, err := serve(internal, w, func(s *grpc.Server) {
+		vagrant_command.RegisterWarningsServiceServer(s, &warningsServer{impl: w})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vagrant_command.Warnings{StreamId: id}, nil
+}
+
+// WarningsFromProto connects to the warnings collector served
+// by core
+func WarningsFromProto(
+	input *vagrant_command.Warnings,
+	internal Internal,
+) (Warnings, error) {
+	conn, err := dial(internal, input.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &warningsClient{
+		client: vagrant_command.NewWarningsServiceClient(conn),
+		logger: internal.Logger(),
+	}, nil
+}
+
+type warningsClient struct {
+	client vagrant_command.WarningsServiceClient
+	logger hclog.Logger
+}
+
+// Add implements Warnings
+func (c *warningsClient) Add(msg string, args ...interface{}) {
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+
+	_, err := c.client.Add(context.Background(),
+		&vagrant_command.Warnings_AddRequest{Message: msg})
+	if err != nil {
+		c.logger.Error("failed to report warning",
+			"warning", msg,
+			"error", err,
+		)
+	}
+}
+
+type warningsServer struct {
+	impl Warnings
+}
+
+func (s *warningsServer) Add(
+	ctx context.Context,
+	req *vagrant_command.Warnings_AddRequest,
+) (*emptypb.Empty, error) {
+	s.impl.Add(req.Message)
+
+	return &emptypb.Empty{}, nil
+}
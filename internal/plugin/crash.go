@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"github.com/armon/circbuf"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+
+	"github.com/hashicorp/vagrant/internal/pkg/circbufsync"
+)
+
+// Number of bytes of plugin stderr output retained for
+// crash diagnostics
+const crashOutputSize = 64 * 1024
+
+// CrashInfo describes a plugin process which has exited
+type CrashInfo struct {
+	Name     string // name of the plugin
+	Location string // location of the plugin executable
+	ExitCode int    // exit code of the process, -1 if unknown
+	Stderr   string // most recent stderr output of the process
+}
+
+// Buffer retaining the most recent stderr output of a plugin
+func newCrashOutput() *circbufsync.Buffer {
+	buf, err := circbuf.NewBuffer(crashOutputSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return circbufsync.New(buf)
+}
+
+// Crash returns information about the plugin process if it
+// has exited. If the plugin is still running, or is not run
+// as a separate process, nil is returned.
+func (p *Plugin) Crash() *CrashInfo {
+	if p.src == nil || !p.src.Exited() {
+		return nil
+	}
+
+	info := &CrashInfo{
+		Name:     p.Name,
+		Location: p.Location,
+		ExitCode: -1,
+	}
+	if p.cmd != nil && p.cmd.ProcessState != nil {
+		info.ExitCode = p.cmd.ProcessState.ExitCode()
+	}
+	if p.stderr != nil {
+		info.Stderr = string(p.stderr.Bytes())
+	}
+
+	return info
+}
+
+// Crashed returns information about the process of the plugin
+// providing the component if it has exited. If the plugin is
+// unknown or still running, nil is returned.
+func (m *Manager) Crashed(
+	n string, // name of plugin
+	t component.Type, // type of component
+) *CrashInfo {
+	p, err := m.Get(n, t)
+	if err != nil {
+		return nil
+	}
+
+	return p.Crash()
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/vagrant/internal/version"
+)
+
+func TestPluginCrash(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugin process requires a POSIX shell")
+	}
+
+	// Plugins not run as a process never report a crash
+	require.Nil(t, TestPlugin(t, nil).Crash())
+
+	p := TestPlugin(t, nil,
+		WithPluginName("crashy"),
+		WithPluginTypes(component.CommandType),
+		WithPluginProcess(exec.Command("/bin/sh", "-c", "echo something broke >&2; exit 3")),
+	)
+	defer p.Close()
+
+	m := TestManager(t, p)
+	require.Eventually(t, func() bool {
+		return m.Crashed("crashy", component.CommandType) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	info := m.Crashed("crashy", component.CommandType)
+	require.Equal(t, "crashy", info.Name)
+	require.Equal(t, 3, info.ExitCode)
+	require.Equal(t, "something broke\n", info.Stderr)
+
+	require.Nil(t, m.Crashed("unknown", component.CommandType))
+}
+
+func TestCommandVersion(t *testing.T) {
+	// Builtin plugins are run from the Vagrant executable
+	require.Equal(t, version.GetVersion().FullVersionNumber(false),
+		commandVersion(builtinCommand("myplugin")))
+	require.Empty(t, commandVersion(exec.Command("/bin/sh")))
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/pluginclient"
+)
+
+// EnvMode controls if plugin processes inherit the host environment
+type EnvMode uint
+
+const (
+	EnvMerge   EnvMode = iota // plugin variables are merged over the host environment
+	EnvReplace                // host environment is not inherited
+)
+
+// Variables set by the plugin client when launching a plugin
+var handshakeVars = []string{
+	"PLUGIN_CLIENT_CERT",
+	"PLUGIN_MAX_PORT",
+	"PLUGIN_MIN_PORT",
+	"PLUGIN_PROTOCOL_VERSIONS",
+}
+
+// Env controls the environment provided to plugin processes.
+//
+// Precedence from lowest to highest is the inherited host
+// environment and then Vars. In EnvMerge mode the full host
+// environment is inherited, and in EnvReplace mode none of
+// it is. When Passthrough is set, only the named host
+// variables are inherited regardless of the mode.
+//
+// The plugin handshake variables are always provided. On
+// Windows the host environment cannot be restricted so it
+// is always inherited and takes precedence over Vars.
+type Env struct {
+	Mode        EnvMode           // inheritance of the host environment
+	Passthrough []string          // host variables forwarded to plugins
+	Vars        map[string]string // variables set for plugins
+}
+
+// Check if the host variable is inherited by plugins
+func (e *Env) inherit(key string) bool {
+	if e.Passthrough != nil {
+		for _, k := range e.Passthrough {
+			if k == key {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return e.Mode == EnvMerge
+}
+
+// Environ returns the environment for a host process as a list
+// of key=value pairs. Host variables are inherited as described
+// for Env and Vars take precedence. On Windows the host
+// environment is always inherited.
+func (e *Env) Environ() []string {
+	if e == nil {
+		return os.Environ()
+	}
+
+	vars := map[string]string{}
+	for _, v := range os.Environ() {
+		k, val, _ := strings.Cut(v, "=")
+		if k == "" || (runtime.GOOS != "windows" && !e.inherit(k)) {
+			continue
+		}
+		vars[k] = val
+	}
+	for k, v := range e.Vars {
+		vars[k] = v
+	}
+
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make([]string, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, fmt.Sprintf("%s=%s", k, vars[k]))
+	}
+
+	return result
+}
+
+// Key which is equal for environments providing the same
+// variables to plugin processes
+func (e *Env) key() string {
+	if e == nil {
+		return ""
+	}
+
+	passthrough := "*"
+	if e.Passthrough != nil {
+		keys := append([]string{}, e.Passthrough...)
+		sort.Strings(keys)
+		passthrough = fmt.Sprintf("%q", keys)
+	}
+
+	keys := make([]string, 0, len(e.Vars))
+	for k := range e.Vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	vars := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vars = append(vars, fmt.Sprintf("%s=%s", k, e.Vars[k]))
+	}
+
+	return fmt.Sprintf("%d %s %q", e.Mode, passthrough, vars)
+}
+
+// Build the command to launch the plugin with the environment
+// applied. The plugin client appends the host environment to the
+// command environment when launching the plugin, which would take
+// precedence over the configured values. To prevent this, the
+// plugin is launched using env(1) which removes host variables
+// which are not inherited and sets the configured values.
+func (e *Env) command(cmd *exec.Cmd) *exec.Cmd {
+	if e == nil {
+		return cmd
+	}
+
+	keys := make([]string, 0, len(e.Vars))
+	for k := range e.Vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	vars := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vars = append(vars, fmt.Sprintf("%s=%s", k, e.Vars[k]))
+	}
+
+	envPath, err := exec.LookPath("env")
+	if runtime.GOOS == "windows" || err != nil {
+		c := *cmd
+		c.Env = append(append([]string{}, cmd.Env...), vars...)
+
+		return &c
+	}
+
+	// Handshake variables set by the plugin client must be kept
+	handshake := map[string]bool{
+		pluginclient.ClientConfig(hclog.NewNullLogger()).MagicCookieKey: true,
+	}
+	for _, k := range handshakeVars {
+		handshake[k] = true
+	}
+
+	args := []string{}
+	for _, v := range os.Environ() {
+		k, _, _ := strings.Cut(v, "=")
+		if k == "" || handshake[k] || e.inherit(k) {
+			continue
+		}
+		args = append(args, "-u", k)
+	}
+	args = append(args, vars...)
+	args = append(args, cmd.Path)
+	if len(cmd.Args) > 1 {
+		args = append(args, cmd.Args[1:]...)
+	}
+
+	c := exec.Command(envPath, args...)
+	c.Dir = cmd.Dir
+	c.Env = cmd.Env
+	c.ExtraFiles = cmd.ExtraFiles
+	c.SysProcAttr = cmd.SysProcAttr
+
+	return c
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+)
+
+// Run the fake plugin with the environment applied. The host
+// environment is appended to the command environment in the
+// same way as the plugin client.
+func runEnvPlugin(t *testing.T, e *Env, vars ...string) string {
+	script := filepath.Join(t.TempDir(), "plugin")
+	content := "#!/bin/sh\n"
+	for _, v := range vars {
+		content += "echo \"" + v + "=${" + v + "}\"\n"
+	}
+	require.NoError(t, os.WriteFile(script, []byte(content), 0755))
+
+	cmd := e.command(exec.Command(script))
+	cmd.Env = append(cmd.Env, os.Environ()...)
+	out, err := cmd.Output()
+	require.NoError(t, err)
+
+	return strings.TrimSpace(string(out))
+}
+
+func TestEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("host environment cannot be restricted on windows")
+	}
+
+	t.Setenv("VAGRANT_TEST_HOST", "host")
+	t.Setenv("VAGRANT_TEST_PROXY", "http://proxy")
+	t.Setenv("VAGRANT_TEST_VALUE", "host")
+	t.Setenv("PLUGIN_TEST_SECRET", "host")
+	t.Setenv("PLUGIN_MIN_PORT", "10000")
+
+	t.Run("merge", func(t *testing.T) {
+		out := runEnvPlugin(t, &Env{
+			Vars: map[string]string{"VAGRANT_TEST_VALUE": "plugin"},
+		}, "VAGRANT_TEST_VALUE", "VAGRANT_TEST_HOST")
+		require.Equal(t, "VAGRANT_TEST_VALUE=plugin\nVAGRANT_TEST_HOST=host", out)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		out := runEnvPlugin(t, &Env{
+			Mode: EnvReplace,
+			Vars: map[string]string{"VAGRANT_TEST_VALUE": "plugin"},
+		}, "VAGRANT_TEST_VALUE", "VAGRANT_TEST_HOST")
+		require.Equal(t, "VAGRANT_TEST_VALUE=plugin\nVAGRANT_TEST_HOST=", out)
+	})
+
+	t.Run("handshake", func(t *testing.T) {
+		out := runEnvPlugin(t, &Env{Mode: EnvReplace},
+			"PLUGIN_MIN_PORT", "PLUGIN_TEST_SECRET")
+		require.Equal(t, "PLUGIN_MIN_PORT=10000\nPLUGIN_TEST_SECRET=", out)
+	})
+
+	t.Run("passthrough", func(t *testing.T) {
+		out := runEnvPlugin(t, &Env{
+			Mode:        EnvReplace,
+			Passthrough: []string{"VAGRANT_TEST_PROXY"},
+		}, "VAGRANT_TEST_PROXY", "VAGRANT_TEST_HOST")
+		require.Equal(t, "VAGRANT_TEST_PROXY=http://proxy\nVAGRANT_TEST_HOST=", out)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		var e *Env
+		out := runEnvPlugin(t, e, "VAGRANT_TEST_HOST")
+		require.Equal(t, "VAGRANT_TEST_HOST=host", out)
+	})
+}
+
+func TestEnvEnviron(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("host environment cannot be restricted on windows")
+	}
+
+	t.Setenv("VAGRANT_TEST_HOST", "host")
+	t.Setenv("VAGRANT_TEST_VALUE", "host")
+
+	env := (&Env{
+		Vars: map[string]string{"VAGRANT_TEST_VALUE": "plugin"},
+	}).Environ()
+	require.Contains(t, env, "VAGRANT_TEST_HOST=host")
+	require.Contains(t, env, "VAGRANT_TEST_VALUE=plugin")
+	require.NotContains(t, env, "VAGRANT_TEST_VALUE=host")
+
+	env = (&Env{
+		Mode: EnvReplace,
+		Vars: map[string]string{"VAGRANT_TEST_VALUE": "plugin"},
+	}).Environ()
+	require.Equal(t, []string{"VAGRANT_TEST_VALUE=plugin"}, env)
+
+	var e *Env
+	require.Contains(t, e.Environ(), "VAGRANT_TEST_HOST=host")
+}
+
+func TestManagerEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("host environment cannot be restricted on windows")
+	}
+
+	var launched []*exec.Cmd
+	defer func(f func(*exec.Cmd) PluginRegistration) { pluginFactory = f }(pluginFactory)
+	pluginFactory = func(cmd *exec.Cmd) PluginRegistration {
+		return func(hclog.Logger) (*Plugin, error) {
+			launched = append(launched, cmd)
+			return TestPlugin(t, &TestPluginWithFakeBroker{},
+				WithPluginName("builtin"),
+				WithPluginTypes(component.CommandType),
+			), nil
+		}
+	}
+
+	root := TestManager(t)
+	require.NoError(t, root.Register(root.factory(exec.Command("/bin/builtin"))))
+	require.Len(t, launched, 1)
+
+	t.Run("without environment", func(t *testing.T) {
+		sub := root.Sub("basis")
+		_, err := sub.Find("builtin", component.CommandType)
+		require.NoError(t, err)
+		require.Len(t, launched, 1)
+	})
+
+	t.Run("with environment", func(t *testing.T) {
+		sub := root.Sub("basis")
+		sub.SetEnv(&Env{Vars: map[string]string{"VAGRANT_TEST_VALUE": "plugin"}})
+		_, err := sub.Find("builtin", component.CommandType)
+		require.NoError(t, err)
+		require.Len(t, launched, 2)
+		require.Contains(t, launched[1].Args, "VAGRANT_TEST_VALUE=plugin")
+
+		// The plugin is only launched again once
+		_, err = sub.Find("builtin", component.CommandType)
+		require.NoError(t, err)
+		require.Len(t, launched, 2)
+
+		names, err := sub.Typed(component.CommandType)
+		require.NoError(t, err)
+		require.Equal(t, []string{"builtin"}, names)
+	})
+
+	t.Run("shared by managers with the same environment", func(t *testing.T) {
+		env := func(v string) *Env {
+			return &Env{Vars: map[string]string{"VAGRANT_TEST_VALUE": v}}
+		}
+		running := root.ProcessStats().Running
+
+		// The plugin is not launched until it is dispensed
+		sub := root.Sub("project")
+		sub.SetEnv(env("shared"))
+		require.Len(t, launched, 2)
+
+		_, err := sub.Find("builtin", component.CommandType)
+		require.NoError(t, err)
+		require.Len(t, launched, 3)
+		require.Contains(t, launched[2].Args, "VAGRANT_TEST_VALUE=shared")
+		require.Equal(t, running+1, root.ProcessStats().Running)
+
+		other := root.Sub("project")
+		other.SetEnv(env("shared"))
+		started, err := other.Start(context.Background(), "builtin", component.CommandType)
+		require.NoError(t, err)
+		require.False(t, started)
+		_, err = other.Find("builtin", component.CommandType)
+		require.NoError(t, err)
+		require.Len(t, launched, 3)
+
+		// A different environment launches another process
+		// which counts against the process limit
+		root.LimitProcesses(running+1, false)
+		defer root.LimitProcesses(0, false)
+		stopped := root.ProcessStats().Stopped
+		different := root.Sub("project")
+		different.SetEnv(env("different"))
+		started, err = different.Start(context.Background(), "builtin", component.CommandType)
+		require.NoError(t, err)
+		require.True(t, started)
+		require.Len(t, launched, 4)
+		require.Contains(t, launched[3].Args, "VAGRANT_TEST_VALUE=different")
+		require.Equal(t, running+1, root.ProcessStats().Running)
+		require.Equal(t, stopped+1, root.ProcessStats().Stopped)
+	})
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/vagrant-plugin-sdk/component"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cleanup"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/pluginclient"
+
+	"github.com/hashicorp/vagrant/internal/version"
 )
 
 // exePath contains the value of os.Executable. We cache the value because
@@ -46,6 +48,10 @@ func Factory(
 		config.Cmd = &cmdCopy
 		config.Logger = nlog
 
+		// Retain recent stderr output for crash diagnostics
+		stderr := newCrashOutput()
+		config.Stderr = stderr
+
 		// Log that we're going to launch this
 		log.Info("launching plugin",
 			"path", cmd.Path,
@@ -114,22 +120,39 @@ func Factory(
 			Types:    info.ComponentTypes(),
 			Options:  info.ComponentOptions(),
 			Mappers:  mappers,
+			Version:  commandVersion(cmd),
 			cleaner:  cleanup.New(),
+			cmd:      &cmdCopy,
 			logger:   nlog.Named(info.Name()),
 			src:      client,
+			stderr:   stderr,
 		}
 
-		// Close the rpcClient when plugin is closed
-		p.Closer(func() error {
-			return rpcClient.Close()
-		})
+		// Stop the plugin process when plugin is closed
+		p.Closer(p.closeClient)
 
 		return
 	}
 }
 
+// Version of the plugin run by the command. Builtin plugins are run
+// from the Vagrant executable so they share the Vagrant version. The
+// version of other plugins is not known.
+func commandVersion(cmd *exec.Cmd) string {
+	if cmd.Path != exePath {
+		return ""
+	}
+
+	return version.GetVersion().FullVersionNumber(false)
+}
+
 // BuiltinFactory creates a factory for a built-in plugin type.
 func BuiltinFactory(name string) PluginRegistration {
+	return Factory(builtinCommand(name))
+}
+
+// Command used to run a built-in plugin type
+func builtinCommand(name string) *exec.Cmd {
 	cmd := exec.Command(exePath, "plugin-run", name)
 
 	// For non-windows systems, we attach stdout/stderr as extra fds
@@ -138,7 +161,7 @@ func BuiltinFactory(name string) PluginRegistration {
 		cmd.ExtraFiles = []*os.File{os.Stdout, os.Stderr}
 	}
 
-	return Factory(cmd)
+	return cmd
 }
 
 func RubyFactory(
@@ -191,9 +214,14 @@ type Instance struct {
 	// Parent component
 	Parent *Instance
 
+	// Version of the plugin providing this component, empty if unknown
+	Version string
+
 	// Closer is a function that should be called to clean up resources
 	// associated with this plugin.
 	Close func() error
+
+	plugin *Plugin // plugin the component was dispensed from
 }
 
 func (i *Instance) Parents() []string {
@@ -12,6 +12,8 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/go-hclog"
@@ -59,14 +61,20 @@ type Manager struct {
 	ctx             context.Context      // Context for the manager
 	discoveredPaths []path.Path          // List of paths this manager has loaded
 	dispenseFuncs   []PluginConfigurator // Configuration functions applied to instances
+	env             *Env                 // Environment for plugin processes
 	instances       componentCache       // Cache for prevlous generated components
+	instancesM      sync.Mutex           // Lock for instances
 	initFuncs       []PluginInitializer  // Initializer functions applied to plugins at creation
 	legacyLoaded    bool                 // Flag that legacy plugins have been loaded
 	legacyBroker    *plugin.GRPCBroker   // Broker for legacy runtime
+	live            int64                // Number of open instances generated by this manager
 	logger          hclog.Logger         // Logger for the manager
 	m               sync.Mutex
 	rubyC           *serverclient.RubyVagrantClient // Client to the Ruby runtime
 	parent          *Manager                        // Parent manager if this is a sub manager
+	procs           *processes                      // Running plugin processes, only set on the root manager
+	relaunched      map[relaunchKey]*Plugin         // Plugins launched again with a sub manager environment, only set on the root manager
+	relaunchedM     sync.Mutex                      // Lock for relaunched
 	srv             []byte                          // Marshalled proto message for plugin manager
 }
 
@@ -85,10 +93,211 @@ func NewManager(
 		dispenseFuncs: []PluginConfigurator{},
 		instances:     make(componentCache),
 		logger:        l,
+		procs:         newProcesses(l),
+		relaunched:    map[relaunchKey]*Plugin{},
 		rubyC:         r,
 	}
 }
 
+// AddLoggerFields attaches fields to the manager logger. Arguments
+// are key/value pairs as accepted by hclog.Logger.With.
+func (m *Manager) AddLoggerFields(args ...interface{}) {
+	m.logger = m.logger.With(args...)
+}
+
+// SetEnv sets the environment provided to plugin processes
+// launched by the manager and its sub managers. Plugins which
+// were launched from a command by a parent manager, such as the
+// builtin plugins, are launched again with the environment
+// applied when first dispensed. The process is shared by all
+// managers with the same environment. It does not modify
+// plugins which have already been launched by this manager.
+func (m *Manager) SetEnv(e *Env) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	m.env = e
+}
+
+// Environment for plugin processes
+func (m *Manager) pluginEnv() *Env {
+	if m.env == nil && m.parent != nil {
+		return m.parent.pluginEnv()
+	}
+
+	return m.env
+}
+
+// Factory used to launch plugin commands
+var pluginFactory = Factory
+
+// Create a factory for the plugin command which applies the
+// plugin environment. The plugin process counts against the
+// process limit until it is stopped.
+func (m *Manager) factory(cmd *exec.Cmd) PluginRegistration {
+	return m.envFactory(cmd, m.pluginEnv)
+}
+
+// Create a factory for the plugin command which applies the
+// environment returned by env each time the process is launched
+func (m *Manager) envFactory(cmd *exec.Cmd, env func() *Env) PluginRegistration {
+	start := func(log hclog.Logger) (*Plugin, error) {
+		p, err := pluginFactory(env().command(cmd))(log)
+		if err != nil {
+			return nil, err
+		}
+		p.Location = cmd.Path
+		p.launch = cmd
+
+		return p, nil
+	}
+
+	return func(log hclog.Logger) (*Plugin, error) {
+		return m.launch(m.ctx, start, log, nil)
+	}
+}
+
+// Launch a plugin process using the registration once the process
+// limit allows it. When a plugin is provided, the launched process
+// replaces the stopped process of the plugin.
+func (m *Manager) launch(
+	ctx context.Context, // context used while waiting for the limit
+	start PluginRegistration, // launches the plugin process
+	log hclog.Logger, // logger for the plugin
+	p *Plugin, // plugin with a stopped process
+) (*Plugin, error) {
+	procs := m.processes()
+	release, err := procs.acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot launch plugin process: %w", err)
+	}
+	defer release()
+
+	np, err := start(log)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		p = np
+		p.launcher = m
+		p.start = start
+	} else {
+		p.attach(np)
+	}
+	procs.add(p)
+
+	return p, nil
+}
+
+// Running plugin processes of the manager tree
+func (m *Manager) processes() *processes {
+	return m.root().procs
+}
+
+// Root manager of the manager tree
+func (m *Manager) root() *Manager {
+	if m.parent != nil {
+		return m.parent.root()
+	}
+
+	return m
+}
+
+// LimitProcesses sets the maximum number of plugin processes which
+// can be running for this manager, its parent, and sub managers.
+// When the limit is reached the least recently used idle process is
+// stopped to launch a new one. If no process is idle, launching
+// waits for a process to stop when wait is true, otherwise
+// ErrProcessLimit is returned. A max of zero removes the limit.
+func (m *Manager) LimitProcesses(max int, wait bool) {
+	m.processes().limit(max, wait)
+}
+
+// ProcessStats returns statistics about the plugin processes
+// of this manager, its parent, and sub managers
+func (m *Manager) ProcessStats() ProcessStats {
+	return m.processes().processStats()
+}
+
+// Start launches the process of the plugin providing the component
+// if the process was stopped. The context is used while waiting for
+// the process limit. Returns true if a process was launched.
+func (m *Manager) Start(
+	ctx context.Context, // context used while waiting for the limit
+	n string, // name of the plugin
+	t component.Type, // type of component
+) (bool, error) {
+	for _, p := range m.Plugins {
+		if p.Name == n && p.HasType(t) {
+			p.m.Lock()
+			defer p.m.Unlock()
+
+			return p.restart(ctx)
+		}
+	}
+
+	// Plugins launched by a parent manager are launched
+	// again with the environment of this manager
+	if m.env != nil && m.parent != nil {
+		if p, err := m.parent.Get(n, t); err == nil && p.launch != nil {
+			lp, launched, err := m.relaunch(p)
+			if err != nil || launched {
+				return launched, err
+			}
+
+			lp.m.Lock()
+			defer lp.m.Unlock()
+
+			return lp.restart(ctx)
+		}
+	}
+
+	if m.parent != nil {
+		return m.parent.Start(ctx, n, t)
+	}
+
+	// Unknown plugins are reported when the component is requested
+	return false, nil
+}
+
+// Key for a plugin launched again with a manager environment
+type relaunchKey struct {
+	env    string  // key of the environment
+	plugin *Plugin // plugin launched by the parent manager
+}
+
+// Launch the plugin of a parent manager again so the environment
+// of this manager is applied to the plugin process. The process is
+// shared with other managers using the same environment, and is
+// tracked by the root manager so it is not listed in the plugins
+// of any manager. Returns true if a process was launched.
+func (m *Manager) relaunch(p *Plugin) (*Plugin, bool, error) {
+	root := m.root()
+	key := relaunchKey{env: m.env.key(), plugin: p}
+
+	root.relaunchedM.Lock()
+	defer root.relaunchedM.Unlock()
+
+	if lp, ok := root.relaunched[key]; ok {
+		return lp, false, nil
+	}
+
+	m.logger.Debug("launching plugin with manager environment",
+		"name", p.Name,
+		"path", p.Location,
+	)
+
+	e := m.env
+	lp, err := m.load(root.envFactory(p.launch, func() *Env { return e }))
+	if err != nil {
+		return nil, false, err
+	}
+	root.relaunched[key] = lp
+
+	return lp, true, nil
+}
+
 // Returns the client to the Ruby runtime
 func (m *Manager) RubyClient() *serverclient.RubyVagrantClient {
 	if m.parent != nil {
@@ -215,7 +424,7 @@ func (m *Manager) LoadBuiltins() (err error) {
 
 	m.logger.Info("loading builtin plugins")
 	for name, _ := range Builtins {
-		if e := m.register(BuiltinFactory(name)); e != nil {
+		if e := m.register(m.factory(builtinCommand(name))); e != nil {
 			err = multierror.Append(err, e)
 		}
 	}
@@ -300,7 +509,7 @@ func (m *Manager) Discover(
 			}
 
 			cmd := exec.Command(fullPath.String())
-			if err := m.register(Factory(cmd)); err != nil {
+			if err := m.register(m.factory(cmd)); err != nil {
 				m.logger.Error("failed to register discovered plugin",
 					"path", fullPath,
 					"error", err,
@@ -362,6 +571,25 @@ func (m *Manager) Find(
 	return m.find(n, t)
 }
 
+// Acquire returns an instance of the requested component like
+// Find and marks the instance as held. The processes of held
+// instances are not stopped while idle. The returned function
+// must be called once the instance is no longer being used.
+func (m *Manager) Acquire(
+	n string, // Name of the plugin
+	t component.Type, // component type of plugin
+) (*Instance, func(), error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	i, err := m.find(n, t)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return i, m.hold(i), nil
+}
+
 // Get a plugin by name
 func (m *Manager) Get(
 	n string, // Name of the plugin
@@ -407,7 +635,18 @@ func (m *Manager) Typed(
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, pt...)
+
+		// Plugins launched again by this manager are only
+		// included once
+		seen := map[string]struct{}{}
+		for _, name := range result {
+			seen[name] = struct{}{}
+		}
+		for _, name := range pt {
+			if _, ok := seen[name]; !ok {
+				result = append(result, name)
+			}
+		}
 	}
 
 	return result, nil
@@ -546,7 +785,21 @@ func (m *Manager) loadInProcessBuiltins() (err error) {
 func (m *Manager) register(
 	factory PluginRegistration, // Function to generate plugin
 ) (err error) {
-	plg, err := factory(m.logger.ResetNamed("vagrant.plugin"))
+	plg, err := m.load(factory)
+	if err != nil {
+		return
+	}
+
+	m.Plugins = append(m.Plugins, plg)
+	return
+}
+
+// Generate the plugin and run the initializers of
+// this manager on it
+func (m *Manager) load(
+	factory PluginRegistration, // Function to generate plugin
+) (plg *Plugin, err error) {
+	plg, err = factory(m.logger.ResetNamed("vagrant.plugin"))
 	if err != nil {
 		return
 	}
@@ -562,11 +815,10 @@ func (m *Manager) register(
 	// Run initializers on new plugin
 	for _, fn := range m.initFuncs {
 		if err = fn(plg, m.logger); err != nil {
-			return
+			return nil, err
 		}
 	}
 
-	m.Plugins = append(m.Plugins, plg)
 	return
 }
 
@@ -581,13 +833,8 @@ func (m *Manager) find(
 	n string, // name of plugin
 	t component.Type, // type of component
 ) (*Instance, error) {
-	// Ensure we have a valid entry in the cache map
-	if _, ok := m.instances[n]; !ok {
-		m.instances[n] = make(componentEntry)
-	}
-
 	// If we already have this instance cached, return it
-	if i, ok := m.instances[n][t]; ok {
+	if i := m.cached(n, t); i != nil {
 		m.logger.Debug("requested component found in local cache",
 			"name", n,
 			"type", t.String(),
@@ -607,10 +854,19 @@ func (m *Manager) find(
 		return nil, err
 	}
 
+	// Track the instance so it can be counted and removed
+	// from the cache when closed
+	m.track(i)
+
 	// If we got it, store it in the cache and make sure
 	// it gets closed when we do
-	if m.isCacheable(t) {
+	if isCacheable(t) {
+		m.instancesM.Lock()
+		if _, ok := m.instances[n]; !ok {
+			m.instances[n] = make(componentEntry)
+		}
 		m.instances[n][t] = i
+		m.instancesM.Unlock()
 	}
 
 	m.closer(func() error {
@@ -625,6 +881,112 @@ func (m *Manager) find(
 	return i, nil
 }
 
+// Returns the cached instance, if available, and updates
+// the last use time of the plugins providing the instance
+// and its parents
+func (m *Manager) cached(
+	n string, // name of plugin
+	t component.Type, // type of component
+) *Instance {
+	m.instancesM.Lock()
+	i, ok := m.instances[n][t]
+	m.instancesM.Unlock()
+	if !ok {
+		return nil
+	}
+
+	for p := i; p != nil; p = p.Parent {
+		if p.plugin != nil {
+			p.plugin.touch()
+		}
+	}
+
+	return i
+}
+
+// Mark the plugins providing the instance and its parents as
+// in use. The returned function releases the hold so the idle
+// time of the plugins starts once the instance is released.
+func (m *Manager) hold(i *Instance) func() {
+	for p := i; p != nil; p = p.Parent {
+		if p.plugin != nil {
+			p.plugin.use()
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			for p := i; p != nil; p = p.Parent {
+				if p.plugin != nil {
+					p.plugin.release()
+				}
+			}
+		})
+	}
+}
+
+// Wrap the instance's close function so the instance is only
+// closed once, is removed from the cache, and is no longer
+// counted as live.
+func (m *Manager) track(i *Instance) {
+	atomic.AddInt64(&m.live, 1)
+
+	var once sync.Once
+	cl := i.Close
+	i.Close = func() (err error) {
+		once.Do(func() {
+			err = cl()
+			atomic.AddInt64(&m.live, -1)
+
+			m.instancesM.Lock()
+			defer m.instancesM.Unlock()
+			if m.instances[i.Name][i.Type] == i {
+				delete(m.instances[i.Name], i.Type)
+			}
+		})
+
+		return
+	}
+}
+
+// Cached returns if an instance of the component is cached
+// and will be reused on the next request
+func (m *Manager) Cached(
+	n string, // name of plugin
+	t component.Type, // type of component
+) bool {
+	m.instancesM.Lock()
+	defer m.instancesM.Unlock()
+
+	_, ok := m.instances[n][t]
+	return ok
+}
+
+// LiveInstances returns the number of instances generated by
+// this manager which have not been closed
+func (m *Manager) LiveInstances() int {
+	return int(atomic.LoadInt64(&m.live))
+}
+
+// ReapIdle stops plugin processes which have not been used within
+// the given duration and have no instances in use. The processes of
+// this manager, its parent, and sub managers are checked. Open
+// instances of a stopped plugin are closed and the process is
+// launched again when the plugin is next used. The plugins which
+// were stopped are returned.
+func (m *Manager) ReapIdle(ttl time.Duration) []*Plugin {
+	cutoff := time.Now().Add(-ttl)
+	reaped := []*Plugin{}
+	for _, p := range m.processes().idle(cutoff) {
+		if p.stopIdle(cutoff) {
+			reaped = append(reaped, p)
+		}
+	}
+
+	return reaped
+}
+
 // This handles fetching a component from this manager or
 // the parent manager. It will prepend any PluginConfigurators
 // defined on this manager to the list it is provided. The result
@@ -657,6 +1019,19 @@ func (m *Manager) fetch(
 		}
 	}
 
+	// Plugins launched by a parent manager do not have the
+	// environment of this manager applied
+	if m.env != nil && m.parent != nil {
+		if p, err := m.parent.Get(n, t); err == nil && p.launch != nil {
+			lp, _, err := m.relaunch(p)
+			if err != nil {
+				return nil, err
+			}
+
+			return lp.instanceOf(t, append(m.parent.Configurators(), cfns...))
+		}
+	}
+
 	// If we have a parent, check if we can fetch it
 	// from the parent
 	if m.parent != nil {
@@ -673,7 +1048,7 @@ func (m *Manager) closer(f func() error) {
 }
 
 // Check if component type can be cached
-func (m *Manager) isCacheable(t component.Type) bool {
+func isCacheable(t component.Type) bool {
 	for _, v := range CacheableComponents {
 		if t == v {
 			return true
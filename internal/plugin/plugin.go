@@ -6,8 +6,10 @@ package plugin
 import (
 	"fmt"
 	"io"
+	"os/exec"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/go-hclog"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/vagrant/builtin/httpdownloader"
 	"github.com/hashicorp/vagrant/builtin/myplugin"
 	"github.com/hashicorp/vagrant/builtin/otherplugin"
+	"github.com/hashicorp/vagrant/internal/pkg/circbufsync"
 )
 
 // Setting this value to `true` will run builtin plugins
@@ -52,12 +55,22 @@ type Plugin struct {
 	Name     string                         // Name of the plugin
 	Types    []component.Type               // Component types supported by this plugin
 	Options  map[component.Type]interface{} // Options for supported components
-
-	cleaner cleanup.Cleanup // Cleanup tasks to perform on closing
-	logger  hclog.Logger
-	m       sync.Mutex
-	manager *Manager       // Plugin manager this plugin belongs to
-	src     *plugin.Client // Client for the plugin
+	Version  string                         // Version of the plugin, empty if unknown
+
+	cleaner    cleanup.Cleanup        // Cleanup tasks to perform on closing
+	cmd        *exec.Cmd              // Command running the plugin process
+	inUse      int                    // Number of instances in use
+	instances  map[*Instance]struct{} // Open instances of the plugin
+	instancesM sync.Mutex             // Lock for instances and usage
+	launch     *exec.Cmd              // Command used to launch the plugin without an environment applied
+	launcher   *Manager               // Manager which launched the plugin process
+	logger     hclog.Logger
+	m          sync.Mutex
+	manager    *Manager            // Plugin manager this plugin belongs to
+	src        *plugin.Client      // Client for the plugin
+	start      PluginRegistration  // Launches the plugin process again once stopped
+	stderr     *circbufsync.Buffer // Recent stderr output of the plugin process
+	used       time.Time           // Last time the plugin was used
 }
 
 // Interface for plugins with mapper support
@@ -121,7 +134,29 @@ func (p *Plugin) Close() (err error) {
 	p.m.Lock()
 	defer p.m.Unlock()
 
-	return p.cleaner.Close()
+	err = p.cleaner.Close()
+	if p.launcher != nil {
+		p.launcher.processes().remove(p, false)
+	}
+
+	return
+}
+
+// Close the client to the plugin and stop the plugin process
+func (p *Plugin) closeClient() (err error) {
+	if p.Client == nil {
+		return nil
+	}
+	if p.src != nil {
+		// Killing the process closes the client before
+		// waiting for the process to exit
+		p.src.Kill()
+	} else {
+		err = p.Client.Close()
+	}
+	p.Client = nil
+
+	return
 }
 
 // Get specific component type from plugin. This is not exported
@@ -139,6 +174,13 @@ func (p *Plugin) instanceOf(
 		"name", p.Name,
 		"type", c.String())
 
+	// Launch the plugin process again if it was stopped
+	if p.start != nil {
+		if _, err = p.restart(p.launcher.ctx); err != nil {
+			return
+		}
+	}
+
 	if !p.HasType(c) {
 		p.logger.Error("unsupported component type requested",
 			"name", p.Name,
@@ -181,10 +223,14 @@ func (p *Plugin) instanceOf(
 		}
 	}
 
+	// Instances which are not cached are in use until closed
+	busy := !isCacheable(c)
+
 	// Create our instance
 	i = &Instance{
 		Component: raw,
 		Close: func() error {
+			p.closed(i, busy)
 			if cl, ok := raw.(io.Closer); ok {
 				return cl.Close()
 			}
@@ -195,7 +241,10 @@ func (p *Plugin) instanceOf(
 		Name:    p.Name,
 		Type:    c,
 		Options: p.Options[c],
+		Version: p.Version,
+		plugin:  p,
 	}
+	p.opened(i, busy)
 
 	// Be sure the instance is close when the plugin is closed
 	p.Closer(func() error {
@@ -220,3 +269,38 @@ func (p *Plugin) types() []string {
 	}
 	return result
 }
+
+// Record an instance of the plugin as open
+func (p *Plugin) opened(i *Instance, busy bool) {
+	p.instancesM.Lock()
+	defer p.instancesM.Unlock()
+
+	if p.instances == nil {
+		p.instances = map[*Instance]struct{}{}
+	}
+	p.instances[i] = struct{}{}
+	if busy {
+		p.inUse++
+	}
+	p.used = time.Now()
+}
+
+// Record an instance of the plugin as closed
+func (p *Plugin) closed(i *Instance, busy bool) {
+	p.instancesM.Lock()
+	if _, ok := p.instances[i]; !ok {
+		p.instancesM.Unlock()
+		return
+	}
+	delete(p.instances, i)
+	if busy {
+		p.inUse--
+	}
+	p.used = time.Now()
+	idle := p.inUse < 1
+	p.instancesM.Unlock()
+
+	if busy && idle {
+		p.idle()
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// ErrProcessLimit is returned when a plugin process cannot be
+// launched because the maximum number of plugin processes are
+// running and none of them are idle
+var ErrProcessLimit = errors.New("maximum number of plugin processes reached")
+
+// ProcessStats provides information about the plugin processes
+// launched by a manager and its sub managers
+type ProcessStats struct {
+	Running   int           // number of plugin processes running
+	Started   uint64        // number of plugin processes launched
+	Stopped   uint64        // number of idle plugin processes stopped
+	LimitHits uint64        // number of launches which reached the limit
+	Queued    uint64        // number of launches which waited for the limit
+	TotalWait time.Duration // total time launches waited for the limit
+	MaxWait   time.Duration // longest time a launch waited for the limit
+}
+
+// processes tracks the running plugin processes of a manager
+// and its sub managers and restricts how many can run at once
+type processes struct {
+	changed  chan struct{}        // closed when a process slot may be available
+	logger   hclog.Logger         // logger for process events
+	max      int                  // maximum running processes (zero is unlimited)
+	running  map[*Plugin]struct{} // plugins with a running process
+	starting int                  // number of processes being launched
+	stats    ProcessStats
+	wait     bool // wait for a slot instead of returning ErrProcessLimit
+
+	m sync.Mutex
+}
+
+func newProcesses(l hclog.Logger) *processes {
+	return &processes{
+		changed: make(chan struct{}),
+		logger:  l,
+		running: map[*Plugin]struct{}{},
+	}
+}
+
+// Set the maximum number of running processes
+func (s *processes) limit(max int, wait bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.max = max
+	s.wait = wait
+	s.notify()
+}
+
+// Wait for a slot to launch a new process. When the limit has been
+// reached, the least recently used idle process is stopped to make
+// room. If no process is idle, the launch waits for a process to
+// be stopped or returns ErrProcessLimit based on the limit. The
+// returned function must be called once the launch is complete.
+func (s *processes) acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+	hit, queued := false, false
+	for {
+		s.m.Lock()
+		if s.max < 1 || len(s.running)+s.starting < s.max {
+			s.starting++
+			if queued {
+				s.queued(time.Since(start))
+			}
+			s.m.Unlock()
+			break
+		}
+		if !hit {
+			hit = true
+			s.stats.LimitHits++
+		}
+		idle := s.leastRecentlyUsed()
+		changed := s.changed
+		s.m.Unlock()
+
+		if idle != nil {
+			s.logger.Debug("stopping idle plugin process to launch another",
+				"name", idle.Name,
+			)
+			idle.stopIdle(time.Time{})
+			continue
+		}
+
+		if !s.wait {
+			return nil, ErrProcessLimit
+		}
+
+		queued = true
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-changed:
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.m.Lock()
+			defer s.m.Unlock()
+
+			s.starting--
+			s.notify()
+		})
+	}, nil
+}
+
+// Record the time a launch waited for the limit. This must
+// be called with the lock held.
+func (s *processes) queued(wait time.Duration) {
+	s.stats.Queued++
+	s.stats.TotalWait += wait
+	if wait > s.stats.MaxWait {
+		s.stats.MaxWait = wait
+	}
+
+	s.logger.Debug("plugin process launch was queued",
+		"wait", wait,
+	)
+}
+
+// Record a launched process
+func (s *processes) add(p *Plugin) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.running[p] = struct{}{}
+	s.stats.Started++
+}
+
+// Record a stopped process
+func (s *processes) remove(p *Plugin, idle bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, ok := s.running[p]; !ok {
+		return
+	}
+	delete(s.running, p)
+	if idle {
+		s.stats.Stopped++
+	}
+	s.notify()
+}
+
+// Processes which have been idle since before the cutoff
+func (s *processes) idle(cutoff time.Time) []*Plugin {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	result := []*Plugin{}
+	for p := range s.running {
+		if used, ok := p.idleSince(); ok && used.Before(cutoff) {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+// Current process statistics
+func (s *processes) processStats() ProcessStats {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	stats := s.stats
+	stats.Running = len(s.running)
+
+	return stats
+}
+
+// Find the idle process which was used least recently. This
+// must be called with the lock held.
+func (s *processes) leastRecentlyUsed() (lru *Plugin) {
+	var lruUsed time.Time
+	for p := range s.running {
+		used, ok := p.idleSince()
+		if !ok {
+			continue
+		}
+		if lru == nil || used.Before(lruUsed) {
+			lru, lruUsed = p, used
+		}
+	}
+
+	return
+}
+
+// Wake launches waiting for a slot
+func (s *processes) wake() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.notify()
+}
+
+// Wake anything waiting for a slot. This must be called
+// with the lock held.
+func (s *processes) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// Launch the process of the plugin again if it was stopped.
+// Returns true if the process was launched. This must be
+// called with the plugin locked.
+func (p *Plugin) restart(ctx context.Context) (bool, error) {
+	if p.start == nil || p.Client != nil {
+		return false, nil
+	}
+
+	p.logger.Debug("launching stopped plugin process",
+		"name", p.Name,
+	)
+
+	if _, err := p.launcher.launch(ctx, p.start, p.logger, p); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Replace the stopped process of the plugin with the process
+// of the newly launched plugin
+func (p *Plugin) attach(np *Plugin) {
+	p.Client = np.Client
+	p.Mappers = np.Mappers
+	p.cmd = np.cmd
+	p.src = np.src
+	p.stderr = np.stderr
+}
+
+// Stop the plugin process if the plugin has been idle since
+// before the cutoff. A zero cutoff stops the process if it is
+// idle at all. Open instances of the plugin are closed and the
+// process is launched again when the plugin is next used.
+func (p *Plugin) stopIdle(cutoff time.Time) bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.start == nil || p.Client == nil {
+		return false
+	}
+	used, ok := p.idleSince()
+	if !ok || (!cutoff.IsZero() && !used.Before(cutoff)) {
+		return false
+	}
+
+	p.logger.Debug("stopping idle plugin process",
+		"name", p.Name,
+		"idle", time.Since(used),
+	)
+
+	p.instancesM.Lock()
+	open := make([]*Instance, 0, len(p.instances))
+	for i := range p.instances {
+		open = append(open, i)
+	}
+	p.instancesM.Unlock()
+
+	for _, i := range open {
+		if err := i.Close(); err != nil {
+			p.logger.Warn("failed to close plugin instance",
+				"name", i.Name,
+				"type", i.Type.String(),
+				"error", err,
+			)
+		}
+	}
+	if err := p.closeClient(); err != nil {
+		p.logger.Warn("failed to stop plugin process",
+			"name", p.Name,
+			"error", err,
+		)
+	}
+	p.launcher.processes().remove(p, true)
+
+	return true
+}
+
+// Returns when the plugin was last used and if no instances
+// of the plugin are currently in use
+func (p *Plugin) idleSince() (time.Time, bool) {
+	p.instancesM.Lock()
+	defer p.instancesM.Unlock()
+
+	return p.used, p.inUse < 1
+}
+
+// Mark the plugin as in use
+func (p *Plugin) use() {
+	p.instancesM.Lock()
+	defer p.instancesM.Unlock()
+
+	p.inUse++
+	p.used = time.Now()
+}
+
+// Mark the plugin as no longer in use
+func (p *Plugin) release() {
+	p.instancesM.Lock()
+	p.inUse--
+	p.used = time.Now()
+	idle := p.inUse < 1
+	p.instancesM.Unlock()
+
+	if idle {
+		p.idle()
+	}
+}
+
+// Wake launches waiting for the process limit since the
+// process of the plugin can now be stopped
+func (p *Plugin) idle() {
+	if p.launcher != nil {
+		p.launcher.processes().wake()
+	}
+}
+
+// Update the last time the plugin was used
+func (p *Plugin) touch() {
+	p.instancesM.Lock()
+	defer p.instancesM.Unlock()
+
+	p.used = time.Now()
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vagrant-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerProcesses(t *testing.T) {
+	launched := map[string]int{}
+	root := TestManager(t)
+	for _, name := range []string{"a", "b"} {
+		name := name
+		require.NoError(t, root.Register(TestProcessPlugin(root, func() *Plugin {
+			launched[name]++
+			return TestPlugin(t, &TestPluginWithFakeBroker{},
+				WithPluginName(name),
+				WithPluginTypes(component.CommandType),
+			)
+		})))
+	}
+	require.Equal(t, 2, root.ProcessStats().Running)
+
+	sub := root.Sub("basis")
+	a, release, err := sub.Acquire("a", component.CommandType)
+	require.NoError(t, err)
+
+	// Only plugins which are not in use are stopped
+	reaped := sub.ReapIdle(0)
+	require.Len(t, reaped, 1)
+	require.Equal(t, "b", reaped[0].Name)
+	require.Equal(t, 1, root.ProcessStats().Running)
+
+	t.Run("error when limit is reached", func(t *testing.T) {
+		sub.LimitProcesses(1, false)
+		_, err := sub.Start(context.Background(), "b", component.CommandType)
+		require.ErrorIs(t, err, ErrProcessLimit)
+		require.Equal(t, 1, launched["b"])
+	})
+
+	t.Run("wait when limit is reached", func(t *testing.T) {
+		sub.LimitProcesses(1, true)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := sub.Start(ctx, "b", component.CommandType)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// Releasing the plugin in use wakes the waiting launch,
+		// which stops the idle plugin to make room
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			release()
+		}()
+		started, err := sub.Start(context.Background(), "b", component.CommandType)
+		require.NoError(t, err)
+		require.True(t, started)
+		require.Equal(t, 2, launched["b"])
+		require.Equal(t, 1, root.ProcessStats().Running)
+		require.False(t, sub.Cached("a", component.CommandType))
+	})
+
+	t.Run("stopped plugin is launched when used", func(t *testing.T) {
+		sub.LimitProcesses(0, false)
+		i, err := sub.Find("a", component.CommandType)
+		require.NoError(t, err)
+		require.NotEqual(t, a, i)
+		require.Equal(t, 2, launched["a"])
+		require.Equal(t, 2, root.ProcessStats().Running)
+	})
+
+	stats := root.ProcessStats()
+	require.Equal(t, uint64(4), stats.Started)
+	require.Equal(t, uint64(2), stats.Stopped)
+	require.Equal(t, uint64(3), stats.LimitHits)
+}
@@ -0,0 +1,997 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: proto/vagrant_command/command.proto
+
+package vagrant_command
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WarningsService_Add_FullMethodName = "/hashicorp.vagrant.command.WarningsService/Add"
+)
+
+// WarningsServiceClient is the client API for WarningsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WarningsServiceClient interface {
+	Add(ctx context.Context, in *Warnings_AddRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type warningsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWarningsServiceClient(cc grpc.ClientConnInterface) WarningsServiceClient {
+	return &warningsServiceClient{cc}
+}
+
+func (c *warningsServiceClient) Add(ctx context.Context, in *Warnings_AddRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarningsService_Add_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WarningsServiceServer is the server API for WarningsService service.
+// All implementations should embed UnimplementedWarningsServiceServer
+// for forward compatibility
+type WarningsServiceServer interface {
+	Add(context.Context, *Warnings_AddRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedWarningsServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedWarningsServiceServer struct {
+}
+
+func (UnimplementedWarningsServiceServer) Add(context.Context, *Warnings_AddRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+
+// UnsafeWarningsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WarningsServiceServer will
+// result in compilation errors.
+type UnsafeWarningsServiceServer interface {
+	mustEmbedUnimplementedWarningsServiceServer()
+}
+
+func RegisterWarningsServiceServer(s grpc.ServiceRegistrar, srv WarningsServiceServer) {
+	s.RegisterService(&WarningsService_ServiceDesc, srv)
+}
+
+func _WarningsService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Warnings_AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarningsServiceServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarningsService_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarningsServiceServer).Add(ctx, req.(*Warnings_AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WarningsService_ServiceDesc is the grpc.ServiceDesc for WarningsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WarningsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.WarningsService",
+	HandlerType: (*WarningsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _WarningsService_Add_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	PrompterService_Input_FullMethodName = "/hashicorp.vagrant.command.PrompterService/Input"
+)
+
+// PrompterServiceClient is the client API for PrompterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PrompterServiceClient interface {
+	Input(ctx context.Context, in *Prompter_InputRequest, opts ...grpc.CallOption) (*Prompter_InputResponse, error)
+}
+
+type prompterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPrompterServiceClient(cc grpc.ClientConnInterface) PrompterServiceClient {
+	return &prompterServiceClient{cc}
+}
+
+func (c *prompterServiceClient) Input(ctx context.Context, in *Prompter_InputRequest, opts ...grpc.CallOption) (*Prompter_InputResponse, error) {
+	out := new(Prompter_InputResponse)
+	err := c.cc.Invoke(ctx, PrompterService_Input_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrompterServiceServer is the server API for PrompterService service.
+// All implementations should embed UnimplementedPrompterServiceServer
+// for forward compatibility
+type PrompterServiceServer interface {
+	Input(context.Context, *Prompter_InputRequest) (*Prompter_InputResponse, error)
+}
+
+// UnimplementedPrompterServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPrompterServiceServer struct {
+}
+
+func (UnimplementedPrompterServiceServer) Input(context.Context, *Prompter_InputRequest) (*Prompter_InputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Input not implemented")
+}
+
+// UnsafePrompterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PrompterServiceServer will
+// result in compilation errors.
+type UnsafePrompterServiceServer interface {
+	mustEmbedUnimplementedPrompterServiceServer()
+}
+
+func RegisterPrompterServiceServer(s grpc.ServiceRegistrar, srv PrompterServiceServer) {
+	s.RegisterService(&PrompterService_ServiceDesc, srv)
+}
+
+func _PrompterService_Input_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Prompter_InputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrompterServiceServer).Input(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrompterService_Input_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrompterServiceServer).Input(ctx, req.(*Prompter_InputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PrompterService_ServiceDesc is the grpc.ServiceDesc for PrompterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PrompterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.PrompterService",
+	HandlerType: (*PrompterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Input",
+			Handler:    _PrompterService_Input_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	ArtifactsService_Add_FullMethodName = "/hashicorp.vagrant.command.ArtifactsService/Add"
+)
+
+// ArtifactsServiceClient is the client API for ArtifactsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArtifactsServiceClient interface {
+	Add(ctx context.Context, in *Artifacts_AddRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type artifactsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArtifactsServiceClient(cc grpc.ClientConnInterface) ArtifactsServiceClient {
+	return &artifactsServiceClient{cc}
+}
+
+func (c *artifactsServiceClient) Add(ctx context.Context, in *Artifacts_AddRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ArtifactsService_Add_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArtifactsServiceServer is the server API for ArtifactsService service.
+// All implementations should embed UnimplementedArtifactsServiceServer
+// for forward compatibility
+type ArtifactsServiceServer interface {
+	Add(context.Context, *Artifacts_AddRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedArtifactsServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedArtifactsServiceServer struct {
+}
+
+func (UnimplementedArtifactsServiceServer) Add(context.Context, *Artifacts_AddRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+
+// UnsafeArtifactsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArtifactsServiceServer will
+// result in compilation errors.
+type UnsafeArtifactsServiceServer interface {
+	mustEmbedUnimplementedArtifactsServiceServer()
+}
+
+func RegisterArtifactsServiceServer(s grpc.ServiceRegistrar, srv ArtifactsServiceServer) {
+	s.RegisterService(&ArtifactsService_ServiceDesc, srv)
+}
+
+func _ArtifactsService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Artifacts_AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactsServiceServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactsService_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactsServiceServer).Add(ctx, req.(*Artifacts_AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArtifactsService_ServiceDesc is the grpc.ServiceDesc for ArtifactsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArtifactsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.ArtifactsService",
+	HandlerType: (*ArtifactsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _ArtifactsService_Add_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	CancelCleanupService_Register_FullMethodName = "/hashicorp.vagrant.command.CancelCleanupService/Register"
+)
+
+// CancelCleanupServiceClient is the client API for CancelCleanupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CancelCleanupServiceClient interface {
+	Register(ctx context.Context, in *CancelCleanup_RegisterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type cancelCleanupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCancelCleanupServiceClient(cc grpc.ClientConnInterface) CancelCleanupServiceClient {
+	return &cancelCleanupServiceClient{cc}
+}
+
+func (c *cancelCleanupServiceClient) Register(ctx context.Context, in *CancelCleanup_RegisterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CancelCleanupService_Register_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelCleanupServiceServer is the server API for CancelCleanupService service.
+// All implementations should embed UnimplementedCancelCleanupServiceServer
+// for forward compatibility
+type CancelCleanupServiceServer interface {
+	Register(context.Context, *CancelCleanup_RegisterRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedCancelCleanupServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCancelCleanupServiceServer struct {
+}
+
+func (UnimplementedCancelCleanupServiceServer) Register(context.Context, *CancelCleanup_RegisterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+
+// UnsafeCancelCleanupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CancelCleanupServiceServer will
+// result in compilation errors.
+type UnsafeCancelCleanupServiceServer interface {
+	mustEmbedUnimplementedCancelCleanupServiceServer()
+}
+
+func RegisterCancelCleanupServiceServer(s grpc.ServiceRegistrar, srv CancelCleanupServiceServer) {
+	s.RegisterService(&CancelCleanupService_ServiceDesc, srv)
+}
+
+func _CancelCleanupService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCleanup_RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CancelCleanupServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CancelCleanupService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CancelCleanupServiceServer).Register(ctx, req.(*CancelCleanup_RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CancelCleanupService_ServiceDesc is the grpc.ServiceDesc for CancelCleanupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CancelCleanupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.CancelCleanupService",
+	HandlerType: (*CancelCleanupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _CancelCleanupService_Register_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	CancelCleanupFuncService_Cleanup_FullMethodName = "/hashicorp.vagrant.command.CancelCleanupFuncService/Cleanup"
+)
+
+// CancelCleanupFuncServiceClient is the client API for CancelCleanupFuncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CancelCleanupFuncServiceClient interface {
+	Cleanup(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type cancelCleanupFuncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCancelCleanupFuncServiceClient(cc grpc.ClientConnInterface) CancelCleanupFuncServiceClient {
+	return &cancelCleanupFuncServiceClient{cc}
+}
+
+func (c *cancelCleanupFuncServiceClient) Cleanup(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CancelCleanupFuncService_Cleanup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelCleanupFuncServiceServer is the server API for CancelCleanupFuncService service.
+// All implementations should embed UnimplementedCancelCleanupFuncServiceServer
+// for forward compatibility
+type CancelCleanupFuncServiceServer interface {
+	Cleanup(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+}
+
+// UnimplementedCancelCleanupFuncServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCancelCleanupFuncServiceServer struct {
+}
+
+func (UnimplementedCancelCleanupFuncServiceServer) Cleanup(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cleanup not implemented")
+}
+
+// UnsafeCancelCleanupFuncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CancelCleanupFuncServiceServer will
+// result in compilation errors.
+type UnsafeCancelCleanupFuncServiceServer interface {
+	mustEmbedUnimplementedCancelCleanupFuncServiceServer()
+}
+
+func RegisterCancelCleanupFuncServiceServer(s grpc.ServiceRegistrar, srv CancelCleanupFuncServiceServer) {
+	s.RegisterService(&CancelCleanupFuncService_ServiceDesc, srv)
+}
+
+func _CancelCleanupFuncService_Cleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CancelCleanupFuncServiceServer).Cleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CancelCleanupFuncService_Cleanup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CancelCleanupFuncServiceServer).Cleanup(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CancelCleanupFuncService_ServiceDesc is the grpc.ServiceDesc for CancelCleanupFuncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CancelCleanupFuncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.CancelCleanupFuncService",
+	HandlerType: (*CancelCleanupFuncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Cleanup",
+			Handler:    _CancelCleanupFuncService_Cleanup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	StdinService_Read_FullMethodName        = "/hashicorp.vagrant.command.StdinService/Read"
+	StdinService_Interactive_FullMethodName = "/hashicorp.vagrant.command.StdinService/Interactive"
+	StdinService_MakeRaw_FullMethodName     = "/hashicorp.vagrant.command.StdinService/MakeRaw"
+	StdinService_Restore_FullMethodName     = "/hashicorp.vagrant.command.StdinService/Restore"
+)
+
+// StdinServiceClient is the client API for StdinService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StdinServiceClient interface {
+	// Read input from core. Each request sent on the stream is
+	// answered with a single response holding the data read.
+	Read(ctx context.Context, opts ...grpc.CallOption) (StdinService_ReadClient, error)
+	Interactive(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Stdin_InteractiveResponse, error)
+	MakeRaw(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Restore(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type stdinServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStdinServiceClient(cc grpc.ClientConnInterface) StdinServiceClient {
+	return &stdinServiceClient{cc}
+}
+
+func (c *stdinServiceClient) Read(ctx context.Context, opts ...grpc.CallOption) (StdinService_ReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StdinService_ServiceDesc.Streams[0], StdinService_Read_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stdinServiceReadClient{stream}
+	return x, nil
+}
+
+type StdinService_ReadClient interface {
+	Send(*Stdin_ReadRequest) error
+	Recv() (*Stdin_ReadResponse, error)
+	grpc.ClientStream
+}
+
+type stdinServiceReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *stdinServiceReadClient) Send(m *Stdin_ReadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *stdinServiceReadClient) Recv() (*Stdin_ReadResponse, error) {
+	m := new(Stdin_ReadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stdinServiceClient) Interactive(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Stdin_InteractiveResponse, error) {
+	out := new(Stdin_InteractiveResponse)
+	err := c.cc.Invoke(ctx, StdinService_Interactive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stdinServiceClient) MakeRaw(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StdinService_MakeRaw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stdinServiceClient) Restore(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StdinService_Restore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StdinServiceServer is the server API for StdinService service.
+// All implementations should embed UnimplementedStdinServiceServer
+// for forward compatibility
+type StdinServiceServer interface {
+	// Read input from core. Each request sent on the stream is
+	// answered with a single response holding the data read.
+	Read(StdinService_ReadServer) error
+	Interactive(context.Context, *emptypb.Empty) (*Stdin_InteractiveResponse, error)
+	MakeRaw(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Restore(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+}
+
+// UnimplementedStdinServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStdinServiceServer struct {
+}
+
+func (UnimplementedStdinServiceServer) Read(StdinService_ReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedStdinServiceServer) Interactive(context.Context, *emptypb.Empty) (*Stdin_InteractiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Interactive not implemented")
+}
+func (UnimplementedStdinServiceServer) MakeRaw(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeRaw not implemented")
+}
+func (UnimplementedStdinServiceServer) Restore(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+
+// UnsafeStdinServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StdinServiceServer will
+// result in compilation errors.
+type UnsafeStdinServiceServer interface {
+	mustEmbedUnimplementedStdinServiceServer()
+}
+
+func RegisterStdinServiceServer(s grpc.ServiceRegistrar, srv StdinServiceServer) {
+	s.RegisterService(&StdinService_ServiceDesc, srv)
+}
+
+func _StdinService_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StdinServiceServer).Read(&stdinServiceReadServer{stream})
+}
+
+type StdinService_ReadServer interface {
+	Send(*Stdin_ReadResponse) error
+	Recv() (*Stdin_ReadRequest, error)
+	grpc.ServerStream
+}
+
+type stdinServiceReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *stdinServiceReadServer) Send(m *Stdin_ReadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *stdinServiceReadServer) Recv() (*Stdin_ReadRequest, error) {
+	m := new(Stdin_ReadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _StdinService_Interactive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StdinServiceServer).Interactive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StdinService_Interactive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StdinServiceServer).Interactive(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StdinService_MakeRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StdinServiceServer).MakeRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StdinService_MakeRaw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StdinServiceServer).MakeRaw(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StdinService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StdinServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StdinService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StdinServiceServer).Restore(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StdinService_ServiceDesc is the grpc.ServiceDesc for StdinService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StdinService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.StdinService",
+	HandlerType: (*StdinServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Interactive",
+			Handler:    _StdinService_Interactive_Handler,
+		},
+		{
+			MethodName: "MakeRaw",
+			Handler:    _StdinService_MakeRaw_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _StdinService_Restore_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Read",
+			Handler:       _StdinService_Read_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	InvokerService_Run_FullMethodName = "/hashicorp.vagrant.command.InvokerService/Run"
+)
+
+// InvokerServiceClient is the client API for InvokerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InvokerServiceClient interface {
+	Run(ctx context.Context, in *Invoker_RunRequest, opts ...grpc.CallOption) (*Invoker_RunResponse, error)
+}
+
+type invokerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInvokerServiceClient(cc grpc.ClientConnInterface) InvokerServiceClient {
+	return &invokerServiceClient{cc}
+}
+
+func (c *invokerServiceClient) Run(ctx context.Context, in *Invoker_RunRequest, opts ...grpc.CallOption) (*Invoker_RunResponse, error) {
+	out := new(Invoker_RunResponse)
+	err := c.cc.Invoke(ctx, InvokerService_Run_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InvokerServiceServer is the server API for InvokerService service.
+// All implementations should embed UnimplementedInvokerServiceServer
+// for forward compatibility
+type InvokerServiceServer interface {
+	Run(context.Context, *Invoker_RunRequest) (*Invoker_RunResponse, error)
+}
+
+// UnimplementedInvokerServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedInvokerServiceServer struct {
+}
+
+func (UnimplementedInvokerServiceServer) Run(context.Context, *Invoker_RunRequest) (*Invoker_RunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Run not implemented")
+}
+
+// UnsafeInvokerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InvokerServiceServer will
+// result in compilation errors.
+type UnsafeInvokerServiceServer interface {
+	mustEmbedUnimplementedInvokerServiceServer()
+}
+
+func RegisterInvokerServiceServer(s grpc.ServiceRegistrar, srv InvokerServiceServer) {
+	s.RegisterService(&InvokerService_ServiceDesc, srv)
+}
+
+func _InvokerService_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Invoker_RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvokerServiceServer).Run(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvokerService_Run_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvokerServiceServer).Run(ctx, req.(*Invoker_RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InvokerService_ServiceDesc is the grpc.ServiceDesc for InvokerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InvokerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.InvokerService",
+	HandlerType: (*InvokerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Run",
+			Handler:    _InvokerService_Run_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	ProgressService_Update_FullMethodName = "/hashicorp.vagrant.command.ProgressService/Update"
+	ProgressService_State_FullMethodName  = "/hashicorp.vagrant.command.ProgressService/State"
+)
+
+// ProgressServiceClient is the client API for ProgressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProgressServiceClient interface {
+	Update(ctx context.Context, in *Progress_State, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	State(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Progress_StateResponse, error)
+}
+
+type progressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProgressServiceClient(cc grpc.ClientConnInterface) ProgressServiceClient {
+	return &progressServiceClient{cc}
+}
+
+func (c *progressServiceClient) Update(ctx context.Context, in *Progress_State, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProgressService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressServiceClient) State(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Progress_StateResponse, error) {
+	out := new(Progress_StateResponse)
+	err := c.cc.Invoke(ctx, ProgressService_State_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProgressServiceServer is the server API for ProgressService service.
+// All implementations should embed UnimplementedProgressServiceServer
+// for forward compatibility
+type ProgressServiceServer interface {
+	Update(context.Context, *Progress_State) (*emptypb.Empty, error)
+	State(context.Context, *emptypb.Empty) (*Progress_StateResponse, error)
+}
+
+// UnimplementedProgressServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedProgressServiceServer struct {
+}
+
+func (UnimplementedProgressServiceServer) Update(context.Context, *Progress_State) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedProgressServiceServer) State(context.Context, *emptypb.Empty) (*Progress_StateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+
+// UnsafeProgressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProgressServiceServer will
+// result in compilation errors.
+type UnsafeProgressServiceServer interface {
+	mustEmbedUnimplementedProgressServiceServer()
+}
+
+func RegisterProgressServiceServer(s grpc.ServiceRegistrar, srv ProgressServiceServer) {
+	s.RegisterService(&ProgressService_ServiceDesc, srv)
+}
+
+func _ProgressService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Progress_State)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressServiceServer).Update(ctx, req.(*Progress_State))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressService_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressServiceServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressService_State_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressServiceServer).State(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProgressService_ServiceDesc is the grpc.ServiceDesc for ProgressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProgressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.ProgressService",
+	HandlerType: (*ProgressServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Update",
+			Handler:    _ProgressService_Update_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _ProgressService_State_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
+
+const (
+	CredentialsService_Get_FullMethodName = "/hashicorp.vagrant.command.CredentialsService/Get"
+)
+
+// CredentialsServiceClient is the client API for CredentialsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CredentialsServiceClient interface {
+	Get(ctx context.Context, in *Credentials_GetRequest, opts ...grpc.CallOption) (*Credentials_GetResponse, error)
+}
+
+type credentialsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCredentialsServiceClient(cc grpc.ClientConnInterface) CredentialsServiceClient {
+	return &credentialsServiceClient{cc}
+}
+
+func (c *credentialsServiceClient) Get(ctx context.Context, in *Credentials_GetRequest, opts ...grpc.CallOption) (*Credentials_GetResponse, error) {
+	out := new(Credentials_GetResponse)
+	err := c.cc.Invoke(ctx, CredentialsService_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CredentialsServiceServer is the server API for CredentialsService service.
+// All implementations should embed UnimplementedCredentialsServiceServer
+// for forward compatibility
+type CredentialsServiceServer interface {
+	Get(context.Context, *Credentials_GetRequest) (*Credentials_GetResponse, error)
+}
+
+// UnimplementedCredentialsServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCredentialsServiceServer struct {
+}
+
+func (UnimplementedCredentialsServiceServer) Get(context.Context, *Credentials_GetRequest) (*Credentials_GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+
+// UnsafeCredentialsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CredentialsServiceServer will
+// result in compilation errors.
+type UnsafeCredentialsServiceServer interface {
+	mustEmbedUnimplementedCredentialsServiceServer()
+}
+
+func RegisterCredentialsServiceServer(s grpc.ServiceRegistrar, srv CredentialsServiceServer) {
+	s.RegisterService(&CredentialsService_ServiceDesc, srv)
+}
+
+func _CredentialsService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Credentials_GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialsServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialsService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialsServiceServer).Get(ctx, req.(*Credentials_GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CredentialsService_ServiceDesc is the grpc.ServiceDesc for CredentialsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CredentialsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.vagrant.command.CredentialsService",
+	HandlerType: (*CredentialsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _CredentialsService_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/vagrant_command/command.proto",
+}
@@ -21,3 +21,14 @@ func TestManager(t testing.T, plugins ...*Plugin) *Manager {
 	pluginManager.Plugins = plugins
 	return pluginManager
 }
+
+// TestProcessPlugin returns a registration for a plugin which the
+// manager handles like a plugin process. The function is called to
+// create the plugin each time the process is launched.
+func TestProcessPlugin(m *Manager, fn func() *Plugin) PluginRegistration {
+	return func(log hclog.Logger) (*Plugin, error) {
+		return m.launch(m.ctx, func(hclog.Logger) (*Plugin, error) {
+			return fn(), nil
+		}, log, nil)
+	}
+}
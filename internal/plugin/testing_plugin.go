@@ -4,11 +4,18 @@
 package plugin
 
 import (
+	"context"
+	"os/exec"
+
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
+	sdk "github.com/hashicorp/vagrant-plugin-sdk"
 	"github.com/hashicorp/vagrant-plugin-sdk/component"
 	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/cleanup"
+	"github.com/hashicorp/vagrant-plugin-sdk/internal-shared/pluginclient"
 	"github.com/mitchellh/go-testing-interface"
+
+	"github.com/hashicorp/vagrant/internal/pkg/circbufsync"
 )
 
 type TestPluginWithFakeBroker struct {
@@ -75,3 +82,56 @@ func WithPluginTypes(types ...component.Type) PluginProperty {
 		return
 	}
 }
+
+func WithPluginOptions(opts map[component.Type]interface{}) PluginProperty {
+	return func(p *Plugin) (err error) {
+		p.Options = opts
+		return
+	}
+}
+
+// WithPluginProcess runs the command as the plugin process. The
+// command is not required to serve the plugin, which allows testing
+// plugin processes which exit unexpectedly.
+func WithPluginProcess(cmd *exec.Cmd) PluginProperty {
+	return func(p *Plugin) (err error) {
+		config := pluginclient.ClientConfig(p.logger)
+		config.Cmd = cmd
+		config.Stderr = newCrashOutput()
+
+		p.cmd = cmd
+		p.stderr = config.Stderr.(*circbufsync.Buffer)
+		p.src = plugin.NewClient(config)
+		p.Closer(func() error {
+			p.src.Kill()
+			return nil
+		})
+
+		// An error is expected if the command does not serve the plugin
+		p.src.Start()
+		return
+	}
+}
+
+// TestBuiltinPlugin returns a Plugin served in process by the plugin
+// SDK. Unlike TestPlugin, the plugin is called over gRPC so values
+// provided to the plugin must cross the plugin boundary.
+func TestBuiltinPlugin(t testing.T, name string, opts ...sdk.Option) *Plugin {
+	log := hclog.New(&hclog.LoggerOptions{Name: "test", Level: hclog.Warn})
+	builtins := NewBuiltins(context.Background(), log)
+	t.Cleanup(builtins.Close)
+
+	opts = append(opts, sdk.WithName(name))
+	if _, err := builtins.Add(name, opts...); err != nil {
+		t.Fatalf("failed to add builtin plugin %s: %s", name, err)
+	}
+	builtins.Start()
+
+	p, err := builtins.Factory(name)(log)
+	if err != nil {
+		t.Fatalf("failed to start builtin plugin %s: %s", name, err)
+	}
+	t.Cleanup(func() { p.Close() })
+
+	return p
+}
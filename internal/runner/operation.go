@@ -64,7 +64,7 @@ func (r *Runner) executeJob(
 	}
 
 	// Work backwards to setup the basis
-	opts = append(opts, core.WithBasisRef(basisRef))
+	opts = append(opts, core.WithConfig(r.opConfig), core.WithBasisRef(basisRef))
 
 	// Load our basis
 	b, err := r.factory.NewBasis(basisRef.ResourceId, opts...)
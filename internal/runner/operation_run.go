@@ -14,7 +14,7 @@ import (
 )
 
 type Runs interface {
-	Run(context.Context, *vagrant_server.Job_CommandOp) error
+	Run(context.Context, *vagrant_server.Job_CommandOp) ([]string, error)
 }
 
 // Keeping this around as an example
@@ -34,9 +34,12 @@ func (r *Runner) executeRunOp(
 
 	var jrr vagrant_server.Job_CommandResult
 
-	err = scope.Run(ctx, op.Command)
+	warnings, err := scope.Run(ctx, op.Command)
 
 	r.logger.Debug("execution of run operation complete", "job", job, "error", err)
+	if len(warnings) > 0 {
+		r.logger.Warn("run operation reported warnings", "job", job, "warnings", warnings)
+	}
 
 	jrr.RunResult = err == nil
 	if err != nil {
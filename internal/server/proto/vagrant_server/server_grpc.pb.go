@@ -32,6 +32,7 @@ const (
 	Vagrant_UpsertTarget_FullMethodName        = "/hashicorp.vagrant.Vagrant/UpsertTarget"
 	Vagrant_DeleteTarget_FullMethodName        = "/hashicorp.vagrant.Vagrant/DeleteTarget"
 	Vagrant_GetTarget_FullMethodName           = "/hashicorp.vagrant.Vagrant/GetTarget"
+	Vagrant_GetTargets_FullMethodName          = "/hashicorp.vagrant.Vagrant/GetTargets"
 	Vagrant_FindTarget_FullMethodName          = "/hashicorp.vagrant.Vagrant/FindTarget"
 	Vagrant_ListTargets_FullMethodName         = "/hashicorp.vagrant.Vagrant/ListTargets"
 	Vagrant_UpsertBox_FullMethodName           = "/hashicorp.vagrant.Vagrant/UpsertBox"
@@ -82,6 +83,8 @@ type VagrantClient interface {
 	UpsertTarget(ctx context.Context, in *UpsertTargetRequest, opts ...grpc.CallOption) (*UpsertTargetResponse, error)
 	DeleteTarget(ctx context.Context, in *DeleteTargetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetTarget(ctx context.Context, in *GetTargetRequest, opts ...grpc.CallOption) (*GetTargetResponse, error)
+	// GetTargets returns the requested targets in a single request.
+	GetTargets(ctx context.Context, in *GetTargetsRequest, opts ...grpc.CallOption) (*GetTargetsResponse, error)
 	FindTarget(ctx context.Context, in *FindTargetRequest, opts ...grpc.CallOption) (*FindTargetResponse, error)
 	ListTargets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTargetsResponse, error)
 	// CRUD operations for box
@@ -258,6 +261,15 @@ func (c *vagrantClient) GetTarget(ctx context.Context, in *GetTargetRequest, opt
 	return out, nil
 }
 
+func (c *vagrantClient) GetTargets(ctx context.Context, in *GetTargetsRequest, opts ...grpc.CallOption) (*GetTargetsResponse, error) {
+	out := new(GetTargetsResponse)
+	err := c.cc.Invoke(ctx, Vagrant_GetTargets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vagrantClient) FindTarget(ctx context.Context, in *FindTargetRequest, opts ...grpc.CallOption) (*FindTargetResponse, error) {
 	out := new(FindTargetResponse)
 	err := c.cc.Invoke(ctx, Vagrant_FindTarget_FullMethodName, in, out, opts...)
@@ -572,6 +584,8 @@ type VagrantServer interface {
 	UpsertTarget(context.Context, *UpsertTargetRequest) (*UpsertTargetResponse, error)
 	DeleteTarget(context.Context, *DeleteTargetRequest) (*emptypb.Empty, error)
 	GetTarget(context.Context, *GetTargetRequest) (*GetTargetResponse, error)
+	// GetTargets returns the requested targets in a single request.
+	GetTargets(context.Context, *GetTargetsRequest) (*GetTargetsResponse, error)
 	FindTarget(context.Context, *FindTargetRequest) (*FindTargetResponse, error)
 	ListTargets(context.Context, *emptypb.Empty) (*ListTargetsResponse, error)
 	// CRUD operations for box
@@ -672,6 +686,9 @@ func (UnimplementedVagrantServer) DeleteTarget(context.Context, *DeleteTargetReq
 func (UnimplementedVagrantServer) GetTarget(context.Context, *GetTargetRequest) (*GetTargetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTarget not implemented")
 }
+func (UnimplementedVagrantServer) GetTargets(context.Context, *GetTargetsRequest) (*GetTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargets not implemented")
+}
 func (UnimplementedVagrantServer) FindTarget(context.Context, *FindTargetRequest) (*FindTargetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindTarget not implemented")
 }
@@ -966,6 +983,24 @@ func _Vagrant_GetTarget_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vagrant_GetTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VagrantServer).GetTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vagrant_GetTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VagrantServer).GetTargets(ctx, req.(*GetTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vagrant_FindTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindTargetRequest)
 	if err := dec(in); err != nil {
@@ -1439,6 +1474,10 @@ var Vagrant_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTarget",
 			Handler:    _Vagrant_GetTarget_Handler,
 		},
+		{
+			MethodName: "GetTargets",
+			Handler:    _Vagrant_GetTargets_Handler,
+		},
 		{
 			MethodName: "FindTarget",
 			Handler:    _Vagrant_FindTarget_Handler,
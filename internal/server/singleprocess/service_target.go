@@ -45,6 +45,22 @@ func (s *service) GetTarget(
 	return &vagrant_server.GetTargetResponse{Target: result}, nil
 }
 
+func (s *service) GetTargets(
+	ctx context.Context,
+	req *vagrant_server.GetTargetsRequest,
+) (*vagrant_server.GetTargetsResponse, error) {
+	result := make([]*vagrant_server.Target, 0, len(req.Targets))
+	for _, ref := range req.Targets {
+		t, err := s.state.TargetGet(ref)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+
+	return &vagrant_server.GetTargetsResponse{Targets: result}, nil
+}
+
 func (s *service) FindTarget(
 	ctx context.Context,
 	req *vagrant_server.FindTargetRequest,
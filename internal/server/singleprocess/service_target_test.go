@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/vagrant-plugin-sdk/proto/vagrant_plugin_sdk"
 	"github.com/hashicorp/vagrant/internal/server/proto/vagrant_server"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -109,6 +111,59 @@ func TestServiceTarget(t *testing.T) {
 		require.Len(listResp.Targets, 0)
 	})
 
+	t.Run("get multiple", func(t *testing.T) {
+		require := require.New(t)
+		client := TestServer(t)
+
+		basisResp, err := client.UpsertBasis(ctx, &vagrant_server.UpsertBasisRequest{
+			Basis: &vagrant_server.Basis{
+				Name: "mybasis",
+				Path: "/dev/null",
+			},
+		})
+		require.NoError(err)
+
+		projectResp, err := client.UpsertProject(ctx, &vagrant_server.UpsertProjectRequest{
+			Project: &vagrant_server.Project{
+				Name:  "myproject",
+				Path:  "/dev/null/project",
+				Basis: &vagrant_plugin_sdk.Ref_Basis{ResourceId: basisResp.Basis.ResourceId},
+			},
+		})
+		require.NoError(err)
+
+		projectRef := &vagrant_plugin_sdk.Ref_Project{ResourceId: projectResp.Project.ResourceId}
+		refs := []*vagrant_plugin_sdk.Ref_Target{}
+		for _, name := range []string{"one", "two"} {
+			resp, err := client.UpsertTarget(ctx, &vagrant_server.UpsertTargetRequest{
+				Target: &vagrant_server.Target{
+					Name:    name,
+					Project: projectRef,
+				},
+			})
+			require.NoError(err)
+			refs = append(refs, &vagrant_plugin_sdk.Ref_Target{
+				ResourceId: resp.Target.ResourceId,
+				Project:    projectRef,
+			})
+		}
+
+		getResp, err := client.GetTargets(ctx, &vagrant_server.GetTargetsRequest{
+			Targets: refs,
+		})
+		require.NoError(err)
+		require.Len(getResp.Targets, 2)
+		require.Equal("one", getResp.Targets[0].Name)
+		require.Equal("two", getResp.Targets[1].Name)
+
+		// a missing target fails the request
+		_, err = client.GetTargets(ctx, &vagrant_server.GetTargetsRequest{
+			Targets: append(refs, &vagrant_plugin_sdk.Ref_Target{ResourceId: "missing"}),
+		})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
 	t.Run("reasonable errors: set without project", func(t *testing.T) {
 		require := require.New(t)
 		client := TestServer(t)
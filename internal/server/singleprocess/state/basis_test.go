@@ -213,6 +213,23 @@ func TestBasis_Update(t *testing.T) {
 		require.NoError(result.Error)
 		require.Equal(int64(1), count)
 	})
+
+	t.Run("Stores metadata", func(t *testing.T) {
+		require, db := RequireAndDB(t)
+
+		basis := Basis{
+			Name:     "default",
+			Path:     "/dev/null",
+			Metadata: MetadataSet{"label.env": "prod"},
+		}
+		result := db.Save(&basis)
+		require.NoError(result.Error)
+
+		var reloadBasis Basis
+		result = db.First(&reloadBasis, &Basis{Model: Model{ID: basis.ID}})
+		require.NoError(result.Error)
+		require.Equal(MetadataSet{"label.env": "prod"}, reloadBasis.Metadata)
+	})
 }
 
 func TestBasis_Delete(t *testing.T) {
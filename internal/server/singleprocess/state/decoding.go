@@ -129,6 +129,8 @@ func (s *State) decoder(output interface{}) *Decoder {
 			componentToProtoHookFunc,
 			stringPtrToPathProtoHookFunc,
 			pathProtoToStringPtrHookFunc,
+			metadataSetFromProtoHookFunc,
+			metadataSetToProtoHookFunc,
 		),
 		Result: output,
 	}
@@ -176,6 +178,8 @@ func decoder(output interface{}) *Decoder {
 			componentToProtoHookFunc,
 			stringPtrToPathProtoHookFunc,
 			pathProtoToStringPtrHookFunc,
+			metadataSetFromProtoHookFunc,
+			metadataSetToProtoHookFunc,
 		),
 		Result: output,
 	}
@@ -858,3 +862,42 @@ func stringPtrToPathProtoHookFunc(
 
 	return &vagrant_plugin_sdk.Args_Path{Path: *s}, nil
 }
+
+func metadataSetFromProtoHookFunc(
+	from, to reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if from != reflect.TypeOf((*vagrant_plugin_sdk.Args_MetadataSet)(nil)) ||
+		to != reflect.TypeOf((MetadataSet)(nil)) {
+		return data, nil
+	}
+
+	m, ok := data.(*vagrant_plugin_sdk.Args_MetadataSet)
+	if !ok {
+		return nil, fmt.Errorf("cannot deserialize metadata, wrong type (%T)", data)
+	}
+
+	result := MetadataSet{}
+	for k, v := range m.Metadata {
+		result[k] = v
+	}
+
+	return result, nil
+}
+
+func metadataSetToProtoHookFunc(
+	from, to reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if from != reflect.TypeOf((MetadataSet)(nil)) ||
+		to != reflect.TypeOf((*vagrant_plugin_sdk.Args_MetadataSet)(nil)) {
+		return data, nil
+	}
+
+	m, ok := data.(MetadataSet)
+	if !ok {
+		return nil, fmt.Errorf("cannot serialize metadata, wrong type (%T)", data)
+	}
+
+	return m.ToProto(), nil
+}
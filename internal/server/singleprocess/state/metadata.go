@@ -32,9 +32,18 @@ func (m MetadataSet) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 }
 
 // Unmarshals the store value back to original type
-func (m MetadataSet) Scan(value interface{}) error {
-	v, ok := value.([]byte)
-	if !ok {
+func (m *MetadataSet) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var v []byte
+	switch s := value.(type) {
+	case string:
+		v = []byte(s)
+	case []byte:
+		v = s
+	default:
 		return fmt.Errorf("Failed to unmarshal JSON value: %v", value)
 	}
 	j := datatypes.JSON{}
@@ -47,7 +56,7 @@ func (m MetadataSet) Scan(value interface{}) error {
 	if err != nil {
 		return err
 	}
-	m = result
+	*m = result
 	return nil
 }
 
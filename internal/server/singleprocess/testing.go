@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/imdario/mergo"
@@ -33,10 +34,12 @@ func TestServer(t testing.T, opts ...Option) *serverclient.VagrantClient {
 // with server.TestServer. It is easier to just use TestServer directly.
 func TestImpl(t testing.T, opts ...Option) pb.VagrantServer {
 	var buf bytes.Buffer
+	var m sync.Mutex
 	l := hclog.New(&hclog.LoggerOptions{
 		Name:            "test",
 		Level:           hclog.Trace,
 		Output:          &buf,
+		Mutex:           &m,
 		IncludeLocation: true,
 	})
 
@@ -46,6 +49,8 @@ func TestImpl(t testing.T, opts ...Option) pb.VagrantServer {
 	)...)
 
 	t.Cleanup(func() {
+		m.Lock()
+		defer m.Unlock()
 		t.Log(buf.String())
 	})
 	require.NoError(t, err)